@@ -18,11 +18,16 @@ func NewAsMapMethod() *Command {
 		genContent = "method/function"
 	)
 
-	const transformerTriggers = "<no condition (empty)>, " + string(generator.RewriteTriggerType) + ", " + string(generator.RewriteTriggerField)
+	const transformerTriggers = "<no condition (empty)>, " + string(generator.RewriteTriggerType) + ", " + string(generator.RewriteTriggerField) +
+		", " + string(generator.RewriteTriggerTag) + ", " + string(generator.RewriteTriggerKind)
+
+	const transformerEngines = string(generator.RewriteEngineFmt) + ", " + string(generator.RewriteEngineExpr) + " (Go expression with " +
+		generator.RewriteValuePlaceholder + " field value placeholder), " + string(generator.RewriteEngineCall) + " (function name like pkg.Func), " +
+		string(generator.RewriteEngineMethod) + " (method name)"
 
 	var transformFieldValueFormat = "trigger" + struc.KeyValueSeparator + "trigger_value" + struc.KeyValueSeparator + "engine" +
 		struc.ReplaceableValueSeparator + "engine_format" + "; supported triggers '" + transformerTriggers +
-		"', engine '" + string(generator.RewriteEngineFmt) + "'"
+		"', engines '" + transformerEngines + "'"
 
 	var (
 		flagSet             = flag.NewFlagSet(cmdName, flag.ExitOnError)
@@ -37,7 +42,9 @@ func NewAsMapMethod() *Command {
 		hardcode            = flagSet.Bool("hardcode", false, "hardcode field name in generated "+genContent+" (don't generate constants based on field name)")
 		fieldValueRewriters = params.MultiVal(flagSet, "rewrite", []string{}, "field value rewriting applied to generated "+genContent+"; "+
 			"format - "+transformFieldValueFormat)
-		flats = params.MultiVal(flagSet, "flat", []string{}, "apply generator to fields of nested structs")
+		flats  = params.MultiVal(flagSet, "flat", []string{}, "apply generator to fields of nested structs")
+		sort   = flagSet.String("sort", "", "an expression that returns the sort key of a field, uses the fields-to-consts expression environment")
+		nested = flagSet.Bool("nested", false, "convert nested struct fields to map[string]any sub-maps and slices of structs to []map[string]any")
 	)
 
 	return New(cmdName, "generates a method or functon that converts the struct to a map", flagSet, func(context *Context) error {
@@ -49,12 +56,12 @@ func NewAsMapMethod() *Command {
 			return kType, g.AddType(kType, generator.BaseConstType)
 		}).If(len(*keyType) == 0, generator.BaseConstType).Else(*keyType); err != nil {
 			return err
-		} else if constants, err := g.GenerateFieldConstants(model, kType, *export, *snake, *all, set.New(*flats)); err != nil {
+		} else if constants, err := g.GenerateFieldConstants(model, kType, *export, *snake, *all, set.New(*flats), *sort); err != nil {
 			return err
 		} else if rewriter, err := coderewriter.New(*fieldValueRewriters); err != nil {
 			return err
 		} else if _, funcName, funcBody, err := g.GenerateAsMapFunc(
-			model, *name, kType, constants, rewriter, *export, *snake, *ref, *fun, *nolint, *hardcode,
+			model, *name, kType, constants, rewriter, *export, *snake, *ref, *fun, *nolint, *hardcode, *nested,
 		); err != nil {
 			return err
 		} else if err := g.AddFuncOrMethod(funcName, funcBody); err != nil {
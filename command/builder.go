@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"go/types"
+	"slices"
 	"strings"
 
 	"github.com/m4gshm/gollections/expr/get"
@@ -30,11 +31,15 @@ import (
 func NewBuilderStruct() *Command {
 	const (
 		cmdName               = "builder"
-		defMethPref           = "Set"
 		default_constructor   = "Build"
 		default_deconstructor = "ToBuilder"
 	)
 
+	const (
+		builderTag        = "builder"
+		requiredTagOption = "required"
+	)
+
 	exportVals := []string{"all", "fields", "methods", "constructor"}
 
 	var (
@@ -47,8 +52,13 @@ func NewBuilderStruct() *Command {
 		buildValue           = flagSet.Bool("build-value", false, "returns value of the builded type in the build (constructor) method (default is pointer)")
 		light                = flagSet.Bool("light", false, "don't generate builder constructor and setters, only fields")
 		toBuilderMethodName  = flagSet.String("deconstructor", "", "generate instance to builder convert method, use "+generator.Autoname+" for autoname ("+default_deconstructor+")")
-		exports              = params.MultiValFixed(flagSet, "export", []string{"methods", "constructor"}, exportVals, "export generated content")
-		nolint               = params.Nolint(flagSet)
+		required             = params.MultiVal(flagSet, "required", []string{}, "a required field name, the build method returns an error if the field setter is not called (the tags '"+builderTag+":\""+requiredTagOption+"\"' and '"+struc.Tag+":\""+struc.OptionRequired+"\"' mark a required field too)")
+		defaultValues        = params.Default(flagSet)
+		collections          = flagSet.Bool("collections", false, "generates adding and removing methods of slice and map fields ("+
+			generator.DefaultAddPrefix+", "+generator.DefaultRemovePrefix+", "+generator.DefaultPutPrefix+", "+generator.DefaultDeletePrefix+")")
+		staged  = flagSet.Bool("staged", false, "generates stage interfaces that require calling the setters of required fields in order before the build method")
+		exports = params.MultiValFixed(flagSet, "export", []string{"methods", "constructor"}, exportVals, "export generated content")
+		nolint  = params.Nolint(flagSet)
 	)
 
 	return New(
@@ -122,15 +132,77 @@ func NewBuilderStruct() *Command {
 
 			builderType := op.IfElse(*chainValue, "", "*") + builderName + typeParams
 
-			parts, err := generateBuilderParts(g, model, uniques, receiver, builderType, methodPrefix, uniqueNames,
-				!(*chainValue), *light, exportMethods, exportFields, *nolint)
+			requiredByFlag := map[string]bool{}
+			for _, fieldName := range *required {
+				requiredByFlag[fieldName] = false
+			}
+			isRequired := func(fieldsModel *struc.Model, fieldName struc.FieldName) bool {
+				if _, ok := requiredByFlag[fieldName]; ok {
+					requiredByFlag[fieldName] = true
+					return true
+				}
+				if fieldsModel.FieldOptions(fieldName).Required {
+					return true
+				}
+				tagValue, ok := fieldsModel.FieldsTagValue[fieldName][builderTag]
+				return ok && slices.Contains(strings.Split(tagValue, ","), requiredTagOption)
+			}
+
+			var setterResult func(struc.FieldName, bool) string
+			stages := []string{}
+			optionalStage := builderName + stageOptionalSuffix
+			if *staged {
+				if *light {
+					return fmt.Errorf("staged builder cannot be generated without setters")
+				}
+				requiredOrder := map[struc.FieldName]int{}
+				for i, fieldName := range requiredFieldNames(model, isRequired) {
+					requiredOrder[fieldName] = i
+					stages = append(stages, builderName+stageWithPrefix+generator.IdentName(fieldName, true))
+				}
+				setterResult = func(fieldName struc.FieldName, required bool) string {
+					if i, ok := requiredOrder[fieldName]; ok && required && i+1 < len(stages) {
+						return stages[i+1] + typeParams
+					}
+					return optionalStage + typeParams
+				}
+			}
+
+			defaults, err := constructor.NewDefaults(g, *defaultValues)
 			if err != nil {
 				return err
 			}
 
-			_, createInstance, err := constructor.GenerateConstructorArgs(g, uniqueNames, "", builderName, typeParams, nil, false, false, false, always.True)
+			var collectionNames func() *unique.Names
+			if *collections {
+				collectionNames = func() *unique.Names {
+					return unique.NewNamesWith(unique.PreInit(append(slice.Of(receiver), paramNames...)...), unique.DistinctBySuffix("_"))
+				}
+			}
+
+			parts, err := generateBuilderParts(g, model, uniques, receiver, builderType, methodPrefix, uniqueNames, isRequired, setterResult,
+				defaults, collectionNames, !(*chainValue), *light, exportMethods, exportFields, *nolint)
 			if err != nil {
 				return err
+			} else if err := defaults.CheckFound(); err != nil {
+				return err
+			}
+			for _, fieldName := range *required {
+				if !requiredByFlag[fieldName] {
+					return fmt.Errorf("required field '%s' not found", fieldName)
+				}
+			}
+			if len(parts.requiredFields) > 0 && *light {
+				return fmt.Errorf("required fields cannot be tracked without setters")
+			} else if len(parts.defaultsInit) > 0 && *light {
+				return fmt.Errorf("default values cannot be applied without the builder constructor")
+			}
+
+			_, createInstance, err := constructor.GenerateConstructorArgs(g, uniqueNames, "", builderName, typeParams, nil, false, false, false, always.True, nil)
+			if err != nil {
+				return err
+			} else if len(parts.defaultsInit) > 0 {
+				createInstance = "&" + builderName + typeParams + "{\n" + parts.defaultsInit + "}"
 			}
 
 			builderConstructorMethodName, builderConstructorMethodBody := constructor.New(*newBuilderMethodName, builderName,
@@ -141,6 +213,24 @@ func NewBuilderStruct() *Command {
 				use.If(*chainValue, "").ElseGet(sum.Of("if ", receiver, " == nil {\n", "return ", op.IfElse(*buildValue, "", "&"), buildedType, typeParams, " {}\n", "}\n")) +
 				"return " + op.IfElse(*buildValue, "", "&") + buildedType + typeParams + " {\n" + parts.constructorMethodBody + "}\n" +
 				"}\n"
+			if *staged {
+				firstStage := optionalStage
+				if len(stages) > 0 {
+					firstStage = stages[0]
+				}
+				builderConstructorMethodName, builderConstructorMethodBody = constructor.New(
+					use.If(*newBuilderMethodName != generator.Autoname, *newBuilderMethodName).ElseGet(sum.Of("New", builderName)), firstStage,
+					typeParamsDecl, typeParams, uniqueNames.Get("r"), true, exportConstructor, *nolint, "", createInstance, nil)
+				if err := generateBuilderStages(g, parts, stages, optionalStage, typeParamsDecl, constrMethodName+"() "+
+					op.IfElse(*buildValue, "", "*")+buildedType+typeParams, *nolint); err != nil {
+					return err
+				}
+			} else if len(parts.requiredFields) > 0 {
+				if instanceConstructorMethodBody, err = generateRequiredBuildMethod(g, parts, uniqueNames, receiver, builderType, constrMethodName,
+					buildedType+typeParams, !(*chainValue), *buildValue, *nolint); err != nil {
+					return err
+				}
+			}
 
 			builderBody := util.TypeString(btyp, g.OutPkgPath) + " struct {" + generator.NoLint(*nolint) + "\n" + parts.structBody + "}"
 
@@ -182,22 +272,36 @@ func NewBuilderStruct() *Command {
 					sum.Of("(", instanceReceiver, " ", instanceType, ") ", *toBuilderMethodName, "() "),
 				)+builderType+" {"+generator.NoLint(*nolint)+"\n"+get.If(!*buildValue,
 					sum.Of("if ", instanceReceiver, " == nil {\nreturn ", builderInstantiate, " {}\n", "}\n"),
-				).Else("")+pre+"return "+builderInstantiate+" {\n"+b+"\n"+"}\n}\n")
+				).Else("")+pre+"return "+builderInstantiate+" {\n"+b+requiredTrackersInit(parts.requiredFields)+"\n"+"}\n}\n")
 			}
 			return nil
 		},
 	)
 }
 
+const (
+	defMethPref         = "Set"
+	stageWithPrefix     = "With"
+	stageOptionalSuffix = "Optional"
+)
+
 type builderParts struct {
 	constructorMethodBody, structBody   string
 	fieldMethodNames, fieldMethodBodies []string
+	requiredFields                      []requiredField
+	optionalSetters                     []string
+	defaultsInit                        string
+}
+
+// requiredField is a field that must be set by the builder setter before the build.
+type requiredField struct {
+	name, tracker, setter string
 }
 
 func generateBuilderParts(
 	g *generator.Generator, model *struc.Model, uniques map[string]string, receiverVar, typeName, setterPrefix string,
-	uniqueNames *unique.Names,
-	isReceiverReference, noMethods, exportMethods, exportFields, nolint bool,
+	uniqueNames *unique.Names, isRequired func(*struc.Model, struc.FieldName) bool, setterResult func(struc.FieldName, bool) string,
+	defaults *constructor.Defaults, collectionNames func() *unique.Names, isReceiverReference, noMethods, exportMethods, exportFields, nolint bool,
 ) (*builderParts, error) {
 	logger.Debugf("generate builder parts: receiver %v, type %v, setterPrefix %v", receiverVar, typeName, setterPrefix)
 	constructorMethodBody := ""
@@ -205,21 +309,30 @@ func generateBuilderParts(
 
 	fieldMethodBodies := []string{}
 	fieldMethodNames := []string{}
-	for i, fieldName := range model.FieldNames {
-		if i > 0 {
+	requiredFields := []requiredField{}
+	optionalSetters := []string{}
+	defaultsInit := ""
+	for fieldName, fieldType := range model.FieldsNameAndType {
+		options := model.FieldOptions(fieldName)
+		if options.Skip {
+			continue
+		} else if len(structBody) > 0 {
 			structBody += "\n"
 		}
-		if fieldType := model.FieldsType[fieldName]; fieldType.Embedded {
+		if fieldType.Embedded {
 			if fullFieldType, err := g.GetFullFieldTypeName(fieldType, true); err != nil {
 				return nil, err
 			} else if embedParts, err := generateBuilderParts(g, fieldType.Model, uniques, receiverVar, typeName, setterPrefix,
-				uniqueNames,
-				isReceiverReference, noMethods, exportMethods, exportFields, nolint); err != nil {
+				uniqueNames, isRequired, setterResult, defaults, collectionNames,
+				isReceiverReference, noMethods, options.IsExport(exportMethods), exportFields, nolint); err != nil {
 				return nil, err
 			} else {
 				init := get.If(fieldType.RefDeep > 0, sum.Of("&", fullFieldType)).Else(fullFieldType)
 				constructorMethodBody += fieldName + ": " + init + "{\n" + embedParts.constructorMethodBody + "\n}"
 				structBody += embedParts.structBody
+				requiredFields = append(requiredFields, embedParts.requiredFields...)
+				optionalSetters = append(optionalSetters, embedParts.optionalSetters...)
+				defaultsInit += embedParts.defaultsInit
 				if !noMethods {
 					fieldMethodBodies = append(fieldMethodBodies, embedParts.fieldMethodBodies...)
 					fieldMethodNames = append(fieldMethodNames, embedParts.fieldMethodNames...)
@@ -237,23 +350,151 @@ func generateBuilderParts(
 			uniques[builderField] = fullFieldType
 			constructorMethodBody += fieldName + ": " + receiverVar + "." + builderField
 			structBody += builderField + " " + fullFieldType
+			if value, ok, err := defaults.Get(model, fieldName, fieldType); err != nil {
+				return nil, err
+			} else if ok {
+				defaultsInit += builderField + ": " + value + ",\n"
+			}
+			tracker := ""
+			required := isRequired(model, fieldName)
+			if required && setterResult == nil {
+				tracker = generator.IdentName(builderField, false) + "Set"
+				if dupl, ok := uniques[tracker]; ok {
+					return nil, fmt.Errorf("duplicated builder fields: name '%s', first type '%s', second 'bool'", tracker, dupl)
+				}
+				uniques[tracker] = "bool"
+				structBody += "\n" + tracker + " bool"
+			}
+			setter := ""
 			if !noMethods {
+				exportMethods := options.IsExport(exportMethods)
 				fieldMethodName := generator.LegalIdentName(generator.IdentName(setterPrefix+builderField, exportMethods))
+				if fieldMethodName == builderField {
+					// the field export rule makes the setter name equal to the builder field name
+					fieldMethodName = generator.LegalIdentName(generator.IdentName(defMethPref+generator.IdentName(builderField, true), exportMethods))
+				}
+				if len(options.Setter) > 0 {
+					fieldMethodName = options.Setter
+				}
 				arg := uniqueNames.Get(generator.LegalIdentName(generator.ArgName(builderField)))
+				resultType := typeName
+				if setterResult != nil {
+					resultType = setterResult(fieldName, required)
+				}
+				setter = fieldMethodName + "(" + arg + " " + fullFieldType + ") " + resultType
 
-				fieldMethod := "func (" + receiverVar + " " + typeName + ") " + fieldMethodName + "(" + arg + " " + fullFieldType + ") " + typeName +
+				fieldMethod := "func (" + receiverVar + " " + typeName + ") " + setter +
 					" {" + generator.NoLint(nolint) + "\n" +
 					get.If(isReceiverReference, sum.Of("if ", receiverVar, " != nil {\n")).Else("") +
 					receiverVar + "." + builderField + "=" + arg + "\n" +
+					get.If(len(tracker) > 0, sum.Of(receiverVar, ".", tracker, " = true\n")).Else("") +
 					op.IfElse(isReceiverReference, "}\n", "") +
 					"return " + receiverVar + "\n}\n"
 				fieldMethodBodies = append(fieldMethodBodies, fieldMethod)
 				fieldMethodNames = append(fieldMethodNames, fieldMethodName)
+
+				if collectionNames != nil {
+					methods, err := g.CollectionMethods(fieldType.Type, fullFieldType, collectionNames())
+					if err != nil {
+						return nil, err
+					}
+					for _, method := range methods {
+						methodName := generator.LegalIdentName(generator.IdentName(method.Prefix+generator.IdentName(builderField, true), exportMethods))
+						signature := methodName + "(" + method.Params + ") " + resultType
+						fieldMethodBodies = append(fieldMethodBodies, "func ("+receiverVar+" "+typeName+") "+signature+
+							" {"+generator.NoLint(nolint)+"\n"+
+							get.If(isReceiverReference, sum.Of("if ", receiverVar, " != nil {\n")).Else("")+
+							method.Body(receiverVar+"."+builderField)+
+							get.If(len(tracker) > 0, sum.Of(receiverVar, ".", tracker, " = true\n")).Else("")+
+							op.IfElse(isReceiverReference, "}\n", "")+
+							"return "+receiverVar+"\n}\n")
+						fieldMethodNames = append(fieldMethodNames, methodName)
+						setter += "\n" + signature
+					}
+				}
+			}
+			if required {
+				requiredFields = append(requiredFields, requiredField{name: fieldName, tracker: tracker, setter: setter})
+			} else if len(setter) > 0 {
+				optionalSetters = append(optionalSetters, setter)
 			}
 		}
 		constructorMethodBody += ",\n"
 	}
-	return &builderParts{constructorMethodBody, structBody, fieldMethodNames, fieldMethodBodies}, nil
+	return &builderParts{constructorMethodBody, structBody, fieldMethodNames, fieldMethodBodies, requiredFields, optionalSetters, defaultsInit}, nil
+}
+
+// requiredFieldNames returns the required fields in the order of the builder setters.
+func requiredFieldNames(model *struc.Model, isRequired func(*struc.Model, struc.FieldName) bool) []struc.FieldName {
+	names := []struc.FieldName{}
+	for fieldName, fieldType := range model.FieldsNameAndType {
+		if model.FieldOptions(fieldName).Skip {
+			continue
+		} else if fieldType.Embedded {
+			names = append(names, requiredFieldNames(fieldType.Model, isRequired)...)
+		} else if isRequired(model, fieldName) {
+			names = append(names, fieldName)
+		}
+	}
+	return names
+}
+
+// generateBuilderStages generates an interface per required field setter and the optional stage interface with the build method.
+func generateBuilderStages(
+	g *generator.Generator, parts *builderParts, stages []string, optionalStage, typeParamsDecl, buildSignature string, nolint bool,
+) error {
+	for i, field := range parts.requiredFields {
+		if err := g.AddStruct(generator.Structure{
+			Name: stages[i],
+			Body: stages[i] + typeParamsDecl + " interface {" + generator.NoLint(nolint) + "\n" + field.setter + "\n}\n",
+		}); err != nil {
+			return err
+		}
+	}
+	return g.AddStruct(generator.Structure{
+		Name: optionalStage,
+		Body: optionalStage + typeParamsDecl + " interface {" + generator.NoLint(nolint) + "\n" +
+			strings.Join(append(slices.Clone(parts.optionalSetters), buildSignature), "\n") + "\n}\n",
+	})
+}
+
+// generateRequiredBuildMethod generates the build method that returns an error if any required field is not set.
+func generateRequiredBuildMethod(
+	g *generator.Generator, parts *builderParts, uniqueNames *unique.Names, receiver, builderType, methodName, buildedType string,
+	isReceiverReference, buildValue, nolint bool,
+) (string, error) {
+	errorsPkg, err := g.GetPackageNameOrAlias("errors", "errors")
+	if err != nil {
+		return "", err
+	}
+	stringsPkg, err := g.GetPackageNameOrAlias("strings", "strings")
+	if err != nil {
+		return "", err
+	}
+	missed := uniqueNames.Get("missed")
+	checks := ""
+	for _, field := range parts.requiredFields {
+		checks += "if " + get.If(isReceiverReference, sum.Of(receiver, " == nil || ")).Else("") + "!" + receiver + "." + field.tracker + " {\n" +
+			missed + " = append(" + missed + ", \"" + field.name + "\")\n}\n"
+	}
+	return "func (" + receiver + " " + builderType + ") " + methodName + "() (" + op.IfElse(buildValue, "", "*") + buildedType + ", error)" +
+		" {" + generator.NoLint(nolint) + "\n" +
+		"var " + missed + " []string\n" + checks +
+		"if len(" + missed + ") > 0 {\n" +
+		"return " + op.IfElse(buildValue, buildedType+"{}", "nil") + ", " + generator.GetTypeName("New", errorsPkg) +
+		"(\"required fields are not set: \" + " + generator.GetTypeName("Join", stringsPkg) + "(" + missed + ", \", \"))\n}\n" +
+		"return " + op.IfElse(buildValue, "", "&") + buildedType + " {\n" + parts.constructorMethodBody + "}, nil\n" +
+		"}\n", nil
+}
+
+func requiredTrackersInit(requiredFields []requiredField) string {
+	init := ""
+	for _, field := range requiredFields {
+		if len(field.tracker) > 0 {
+			init += field.tracker + ": true,\n"
+		}
+	}
+	return init
 }
 
 func generateToBuilderMethodParts(
@@ -261,7 +502,9 @@ func generateToBuilderMethodParts(
 ) (methodBody string, varsPart string, err error) {
 	logger.Debugf("generate toBuilder method: receiver %v", receiver)
 	for fieldName, fieldType := range model.FieldsNameAndType {
-		if !fieldType.Embedded {
+		if model.FieldOptions(fieldName).Skip {
+			continue
+		} else if !fieldType.Embedded {
 			builderField := generator.LegalIdentName(generator.IdentName(fieldName, exportFields))
 			methodBody += builderField + ": " + receiver + "." + get.If(len(fieldPrefix) > 0, sum.Of(fieldPrefix, ".")).Else("") + fieldName + ",\n"
 		} else if fieldPath, conditionalPath, conditions := generator.FiledPathAndAccessCheckCondition(
@@ -298,7 +541,9 @@ func generateToBuilderMethodConditionedParts(
 	initVars += varsConditionStart
 
 	for fieldName, fieldType := range model.FieldsNameAndType {
-		if fieldType.Embedded {
+		if model.FieldOptions(fieldName).Skip {
+			continue
+		} else if fieldType.Embedded {
 			fieldPath, conditionalPath, subConditions := generator.FiledPathAndAccessCheckCondition(conditionalPath, false, false,
 				[]generator.FieldInfo{{Name: fieldType.Name, Type: fieldType}}, uniqueNames)
 			fullFielPath := parentPath + get.If(len(fieldPath) > 0, sum.Of(".", fieldPath)).Else("")
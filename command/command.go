@@ -75,11 +75,16 @@ func PrintUsage() {
 var commands = []func() *Command{
 	NewFieldsToConsts,
 	NewAsMapMethod,
+	NewFieldsMeta,
 	NewNewOpt,
 	NewNewFull,
 	NewBuilderStruct,
 	NewGettersSetters,
 	NewEnrichConstType,
+	NewSealed,
+	NewInterface,
+	NewMock,
+	NewDecorator,
 }
 
 var index = slice.Map(commands, getCommandFuncName, as.Is)
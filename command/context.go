@@ -2,10 +2,15 @@ package command
 
 import (
 	"go/ast"
+	"go/token"
+
+	"github.com/m4gshm/gollections/c"
+	"golang.org/x/tools/go/packages"
 
 	"github.com/m4gshm/fieldr/generator"
 	"github.com/m4gshm/fieldr/logger"
 	"github.com/m4gshm/fieldr/model/enum"
+	"github.com/m4gshm/fieldr/model/iface"
 	"github.com/m4gshm/fieldr/model/struc"
 	"github.com/m4gshm/fieldr/model/util"
 	"github.com/m4gshm/fieldr/use"
@@ -15,8 +20,11 @@ type Context struct {
 	Generator   *generator.Generator
 	structModel *struc.Model
 	enumModel   *enum.Model
+	ifaceModel  *iface.Model
 	Typ         util.TypeNamedOrAlias
 	TypFile     *ast.File
+	FileSet     *token.FileSet
+	Packages    c.Range[*packages.Package]
 }
 
 func (c *Context) StructModel() (*struc.Model, error) {
@@ -46,3 +54,17 @@ func (c *Context) EnumModel() (*enum.Model, error) {
 	c.enumModel = model
 	return model, err
 }
+
+func (c *Context) InterfaceModel() (*iface.Model, error) {
+	if m := c.ifaceModel; m != nil {
+		return m, nil
+	}
+	if c.Typ == nil {
+		logger.Debugf("error config without type")
+		return nil, use.Err("no type in context")
+	}
+
+	model, err := iface.New(c.Typ)
+	c.ifaceModel = model
+	return model, err
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"flag"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/params"
+)
+
+func NewDecorator() *Command {
+	const (
+		cmdName = "decorator"
+	)
+	var (
+		flagSet = flag.NewFlagSet(cmdName, flag.ExitOnError)
+		name    = flagSet.String("name", generator.Autoname, "decorator type name, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultDecoratorSuffix+" as default)")
+		before  = flagSet.String("before", "Before", "a hook field name that is called with the method name and arguments before the method")
+		after   = flagSet.String("after", "After", "a hook field name that is called with the method name and results after the method")
+		nolint  = params.Nolint(flagSet)
+	)
+
+	return New(
+		cmdName, "generates a wrapper struct of the interface type that calls hooks around the methods",
+		flagSet,
+		func(context *Context) error {
+			model, err := context.InterfaceModel()
+			if err != nil {
+				return err
+			}
+			g := context.Generator
+			s, err := g.GenerateDecorator(model, *name, *before, *after, *nolint)
+			if err != nil {
+				return err
+			}
+			return g.AddStruct(s)
+		},
+	)
+}
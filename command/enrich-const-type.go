@@ -2,11 +2,15 @@ package command
 
 import (
 	"flag"
+	"fmt"
+	"go/constant"
 	"go/types"
+	"strings"
 
 	"github.com/m4gshm/flag/flagenum"
 	"github.com/m4gshm/gollections/collection/immutable"
 	ordermap "github.com/m4gshm/gollections/collection/immutable/ordered/map_"
+	"github.com/m4gshm/gollections/convert/as"
 	"github.com/m4gshm/gollections/slice"
 	"github.com/m4gshm/gollections/slice/group"
 
@@ -24,27 +28,43 @@ func NewEnrichConstType() *Command {
 	type apiMethod string
 	const (
 		nameMeth      apiMethod = "getter"
+		aliasesMeth   apiMethod = "aliases"
 		allFunc       apiMethod = "all"
 		fromNameFunc  apiMethod = "from-name"
 		fromValueFunc apiMethod = "from-value"
 	)
+	type duplicatesMode string
+	const (
+		duplicatesNames     duplicatesMode = "names"
+		duplicatesCanonical duplicatesMode = "canonical"
+		duplicatesFail      duplicatesMode = "fail"
+	)
 	var (
 		flagSet             = flag.NewFlagSet(name, flag.ExitOnError)
 		toStringMethodName  = flagSet.String("get-name", "Name", "a getter name that returns the constant name")
+		aliasesMethodName   = flagSet.String("get-aliases", "Aliases", "a getter name that returns alternate names of the constant (names of the constants with the same value except the canonical one)")
 		fromNameMethodName  = flagSet.String("from-name", generator.Autoname, "a function name that returns a constant of the set by its name, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultMethodSuffixByName+" as default)")
 		fromValueMethodName = flagSet.String("from-value", generator.Autoname, "a function name that returns a constant of the set by its underlying type value, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultMethodSuffixByValue+" as default)")
 		valuesMethodName    = flagSet.String("all-func", generator.Autoname, "a function name that returns a slice contains all constants of the set, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultMethodSuffixAll+" as default)")
+		canonical           = params.MultiVal(flagSet, "canonical", []string{}, "a constant name used as the canonical one for its value when several constants share the value (the first one by name order is used by default)")
+		sortExpr            = flagSet.String("sort", "", "an expression that returns the sort key of a constant value, can use 'name', 'names', 'value' and 'type' properties; the constants are sorted in the declaration order by default")
 		export              = params.Export(flagSet)
 		nolint              = params.Nolint(flagSet)
 	)
 	defaultApis := slice.Of(nameMeth, fromNameFunc, fromValueFunc, allFunc)
-	allowedApis := slice.Of(nameMeth, fromNameFunc, fromValueFunc, allFunc)
+	allowedApis := slice.Of(nameMeth, aliasesMeth, fromNameFunc, fromValueFunc, allFunc)
 	apis, err := flagenum.Multiple(flagSet, "api", defaultApis, allowedApis, fromString[apiMethod], toString[apiMethod], "generated api method or functions")
 	if err != nil {
 		panic(err)
 	}
+	duplicates, err := flagenum.Single(flagSet, "duplicates", duplicatesNames, slice.Of(duplicatesNames, duplicatesCanonical, duplicatesFail),
+		fromString[duplicatesMode], toString[duplicatesMode], "handling of constants that share a value: '"+string(duplicatesNames)+
+			"' - the name getter returns all names of the value, '"+string(duplicatesCanonical)+"' - the name getter returns the canonical name only, '"+
+			string(duplicatesFail)+"' - fails the generation")
+	if err != nil {
+		panic(err)
+	}
 
-	selectedApis := immutable.NewSet(*apis...)
 	return New(
 		name, "extends a constant set type with functions and methods",
 		flagSet,
@@ -54,10 +74,25 @@ func NewEnrichConstType() *Command {
 			if err != nil {
 				return err
 			}
-			constValNamesMap := ordermap.New(group.Order(model.Consts(), (*types.Const).Val, (*types.Const).Name))
+			order, valNames, err := groupConstsByValue(g, model.Consts(), *canonical, *duplicates == duplicatesFail)
+			if err != nil {
+				return err
+			} else if err := generator.SortEnumValues(model.Typ(), order, valNames, *sortExpr); err != nil {
+				return err
+			}
+			constValNamesMap := ordermap.New(order, valNames)
 			typ := model.Typ()
+			selectedApis := immutable.NewSet(*apis...)
 			if selectedApis.Contains(nameMeth) {
-				funcName, funcBody, err := g.GenerateEnumName(typ, constValNamesMap, *toStringMethodName, *export, *nolint)
+				funcName, funcBody, err := g.GenerateEnumName(typ, constValNamesMap, *toStringMethodName, *duplicates == duplicatesCanonical, *export, *nolint)
+				if err != nil {
+					return err
+				} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+			}
+			if selectedApis.Contains(aliasesMeth) {
+				funcName, funcBody, err := g.GenerateEnumAliases(typ, constValNamesMap, *aliasesMethodName, *export, *nolint)
 				if err != nil {
 					return err
 				} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
@@ -92,3 +127,39 @@ func NewEnrichConstType() *Command {
 		},
 	)
 }
+
+// groupConstsByValue groups constant names by value, the canonical name of a value is placed first.
+func groupConstsByValue(
+	g *generator.Generator, consts []*types.Const, canonical []string, failOnDuplicates bool,
+) ([]constant.Value, map[constant.Value][]string, error) {
+	isCanonical := immutable.NewSet(canonical...).Contains
+	constPosition := func(cnst *types.Const) string { return cnst.Name() + " (" + g.Position(cnst.Pos()).String() + ")" }
+	order, valConsts := group.Order(consts, (*types.Const).Val, as.Is[*types.Const])
+	valNames := make(map[constant.Value][]string, len(order))
+	usedCanonical := map[string]struct{}{}
+	for _, val := range order {
+		sameValConsts := valConsts[val]
+		if failOnDuplicates && len(sameValConsts) > 1 {
+			return nil, nil, fmt.Errorf("constants share the value %s: %s", val.ExactString(),
+				strings.Join(slice.Convert(sameValConsts, constPosition), ", "))
+		}
+		canonicalConsts := slice.Filter(sameValConsts, func(cnst *types.Const) bool { return isCanonical(cnst.Name()) })
+		if len(canonicalConsts) > 1 {
+			return nil, nil, fmt.Errorf("several canonical constants of the value %s: %s", val.ExactString(),
+				strings.Join(slice.Convert(canonicalConsts, constPosition), ", "))
+		}
+		names := slice.Convert(sameValConsts, (*types.Const).Name)
+		if len(canonicalConsts) == 1 {
+			canonicalName := canonicalConsts[0].Name()
+			usedCanonical[canonicalName] = struct{}{}
+			names = append([]string{canonicalName}, slice.Filter(names, func(name string) bool { return name != canonicalName })...)
+		}
+		valNames[val] = names
+	}
+	for _, name := range canonical {
+		if _, ok := usedCanonical[name]; !ok {
+			return nil, nil, fmt.Errorf("canonical constant '%s' not found", name)
+		}
+	}
+	return order, valNames, nil
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"flag"
+
+	"github.com/m4gshm/gollections/collection/immutable/set"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/params"
+)
+
+func NewFieldsMeta() *Command {
+	const (
+		name = "fields-meta"
+	)
+	var (
+		flagSet  = flag.NewFlagSet(name, flag.ExitOnError)
+		typeName = flagSet.String("meta-type", "", "field metadata type name, default <Type>"+generator.DefaultFieldMetaType)
+		listName = flagSet.String("name", "", "function name that returns metadata of all fields, default <Type>"+generator.DefaultFieldsMetaName)
+		byName   = flagSet.String("by-name", "", "function name that returns metadata of a field by the name, default <Type>"+generator.DefaultFieldMetaByName)
+		byTag    = flagSet.String("by-tag", "", "function name that returns metadata of a field by the tag name value, default <Type>"+generator.DefaultFieldMetaByTag)
+		export   = params.Export(flagSet)
+		all      = flagSet.Bool("all", false, "use exported and private fields")
+		nolint   = params.Nolint(flagSet)
+		flats    = params.MultiVal(flagSet, "flat", []string{}, "apply generator to fields of nested structs")
+	)
+	c := New(
+		name, "generates field metadata: names, constants, tags, types, value getters and setters",
+		flagSet,
+		func(context *Context) error {
+			model, err := context.StructModel()
+			if err != nil {
+				return err
+			}
+			names := generator.DefaultFieldsMetaNames(model.TypeName(), *export)
+			if len(*typeName) > 0 {
+				names.Type = *typeName
+			}
+			if len(*listName) > 0 {
+				names.List = *listName
+			}
+			if len(*byName) > 0 {
+				names.ByName = *byName
+			}
+			if len(*byTag) > 0 {
+				names.ByTag = *byTag
+			}
+			return context.Generator.GenerateFieldsMeta(model, names, *export, *all, *nolint, set.New(*flats))
+		},
+	)
+	c.manual = `Generates:
+	<Type>Field constants of the struct fields,
+	<Type>FieldMeta type with the field name, constant, type, tags, the value getter and setter,
+	<Type>FieldsMeta function that returns the metadata of all fields,
+	<Type>FieldMetaByName function that returns the metadata of a field by the field name,
+	<Type>FieldMetaByTag function that returns the metadata of a field by a tag and the tag name value like 'id' of json:"id,omitempty".
+The setter returns false if the value type differs from the field type or an embedded pointer field is nil.`
+	return c
+}
@@ -24,6 +24,9 @@ func NewFieldsToConsts() *Command {
 		fieldNameAccess    = flagSet.String("field-name-access", "", "add a method that returns the associated struct field name, use "+generator.Autoname+" for autoname")
 		refAccessor        = flagSet.String("ref-access", "", "add a function or method that returns a reference to the struct field for each generated constant, use "+generator.Autoname+" for autoname")
 		valAccessor        = flagSet.String("val-access", "", "add a function or method that returns a value to the struct field for each generated constant, use "+generator.Autoname+" for autoname")
+		setAccessor        = flagSet.String("set-access", "", "add a function or method that sets a value of the struct field by a generated constant and returns an error on a value type mismatch, use "+generator.Autoname+" for autoname")
+		typedAccessor      = flagSet.String("typed-access", "", "add generic functions that get and set a typed value of the struct field by a generated constant, the setter name is the getter name with the 'Set' prefix, use "+generator.Autoname+" for autoname")
+		forEachAccessor    = flagSet.String("for-each", "", "add a function or method that iterates over generated constants and the struct field values, use "+generator.Autoname+" for autoname")
 		funcList           = flagSet.String("list", "", "generate function that return list of all generated constant values, use "+generator.Autoname+" for autoname")
 		compact            = flagSet.Bool("compact", false, "generate single line code in aggregate functions, constants")
 		export             = params.ExportCont(flagSet, "constants")
@@ -32,7 +35,13 @@ func NewFieldsToConsts() *Command {
 		flat               = params.Flat(flagSet)
 		excluded           = params.MultiVal(flagSet, "exclude", []string{}, "excluded field name")
 		include            = flagSet.String("include", "", "An expression that determines whether the field is used to create constants")
+		sortExpr           = flagSet.String("sort", "", "an expression that returns the sort key of a generated constant, the constants are sorted in the field declaration order by default")
 		uniqueValues       = flagSet.Bool("check-unique-val", false, "checks if generated constant values are unique")
+		flatAll            = flagSet.Bool("flat-all", false, "apply generator to fields of all nested non-embedded structs recursively, recursive types are not expanded")
+		flatDepth          = flagSet.Int("flat-depth", 0, "max nesting level of -flat-all, 0 - unlimited")
+		pathNameSep        = flagSet.String("path-name-sep", "", "separator of nested field path parts in auto generated constant names")
+		pathValSep         = flagSet.String("path-val-sep", ".", "separator of nested field path parts of the field.fullPath expression property")
+		vars               = flagSet.Bool("var", false, "generate variables instead of constants, the value expression must return Go code of the variable value, the type is optional and is not declared")
 	)
 	c := New(
 		name, "generates constants based on expressions applied to struct fields",
@@ -44,8 +53,9 @@ func NewFieldsToConsts() *Command {
 				return err
 			}
 			return g.GenerateFieldConstant(
-				m, *constValue, *constName, *constType, *funcList, *fieldNameAccess, *refAccessor, *valAccessor, *export, false, *nolint, *compact, *private, *notDeclateConsType, *uniqueValues,
-				set.New(*flat), set.New(*excluded), *include,
+				m, *constValue, *constName, *constType, *funcList, *fieldNameAccess, *refAccessor, *valAccessor, *setAccessor, *typedAccessor, *forEachAccessor, *export, false, *nolint, *compact, *private, *notDeclateConsType, *uniqueValues, *vars,
+				set.New(*flat), set.New(*excluded), *include, *sortExpr,
+				generator.FlatPaths{All: *flatAll, MaxDepth: *flatDepth, NameSep: *pathNameSep, ValueSep: *pathValSep},
 			)
 		},
 	)
@@ -56,6 +66,7 @@ func NewFieldsToConsts() *Command {
 	` + name + ` -` + flagVal + ` 'tag.json' -include 'tag.json != nil' - same as the previous one, but only includes filled tags.
 	` + name + ` -` + flagVal + ` 'rexp("(\w+),?", tag.json)' - using 'regexp' function to extract json property name as constant value with removed ',omitempty' option.
 	` + name + ` -` + flagName + ` 'struct.name + field.name | up()' -` + flagVal + ` 'tag.json' - concatenates type name with field name and converts it to uppercase using 'up' function"
+	` + name + ` -var -` + flagName + ` '"Col" + name' -` + flagVal + ` '"Column[" + struct.type + ", " + field.typeName + "]{Name: " + quote(tag.db) + "}"' - generates typed variables like 'var ColID = Column[Entity, int]{Name: "ID"}'
 Main functions:
 	join, conc - strings concatenation; multiargs
 	OR - select first non empty string argument; multiargs
@@ -63,11 +74,33 @@ Main functions:
 	up - convert string to upper case
 	low - convert string to lower case
 	snake - convert camel to snake case
+	quote - convert value to Go string literal; useful for -var expressions
 Metadata access:
 	name, field.name - current field name
 	field.type - current field type
-	struct.type - struct type name
+	field.typeName - current field type string relative to the output package
+	field.kind - basic, pointer, slice, array, map, chan, struct, interface, func or typeparam
+	field.elem, field.key - element and key types of pointer, slice, array, chan and map fields
+	field.base, field.refDeep - field type without pointers and the pointers count
+	field.embedded, field.exported - field flags
+	field.path - dot separated parent fields of flat and embedded struct fields
+	field.fullPath - parent fields and the field name separated by -path-val-sep, like Address.City.Name
+	field.index - field index in the struct declaration
+	field.doc, field.comment - field doc and line comments
+	struct.name - struct type name
+	struct.type - struct type with type parameters
+	struct.typeParams - struct type parameter names
 	tag.<tag name> - access to tag name
+	tag.<tag name>.name, tag.<tag name>.options - parsed tag value, like 'id' and ['omitempty'] of json:"id,omitempty"
+	tag.<tag name>.has(option), tag.<tag name>.value(key) - checks the tag option, returns the value of the key=value or key:value option
+Nested structs:
+	-flat-all generates constants for every leaf field path like Address.City.Name, -flat-depth limits the nesting level;
+	auto generated names join the path parts by -path-name-sep, value accessors check nil pointers of the path
+Sorting:
+	the -sort expression returns a sort key of a constant, numbers, strings, booleans and lists of them are supported;
+	in addition to the field properties it can use value and const - the constant value and name, example: -sort "[tag.order != '' ? int(tag.order) : 100, name]"
+Multiple constants per field:
+	the -` + flagVal + ` expression can return a list or a map, the -` + flagName + ` expression is evaluated per value with the value, index and key variables or can return a list of names
 More info about expressions definition can be found here https://expr-lang.org/docs/language-definition`
 
 	return c
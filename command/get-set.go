@@ -3,12 +3,15 @@ package command
 import (
 	"flag"
 	"fmt"
+	"maps"
 
+	"github.com/m4gshm/gollections/collection/immutable"
 	"github.com/m4gshm/gollections/op"
 
 	"github.com/m4gshm/fieldr/generator"
 	"github.com/m4gshm/fieldr/logger"
 	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
 	"github.com/m4gshm/fieldr/params"
 )
 
@@ -21,9 +24,17 @@ func NewGettersSetters() *Command {
 		getPrefix       = flagSet.String("get-prefix", "", "getter methods prefix")
 		setPrefix       = flagSet.String("set-prefix", "Set", "setter methods prefix")
 		noExportMethods = flagSet.Bool("no-export", false, "no export generated methods")
-		noRefReceiver   = flagSet.Bool("no-ref", false, "use value type (not pointer) for methods receiver")
-		accessors       = flagSet.String("accessors", "get-set", "full access methods or getter or setter only (supported: get-set, get, set)")
-		nolint          = params.Nolint(flagSet)
+		noRefReceiver   = flagSet.Bool("no-ref", false, "use value type (not pointer) for methods receiver, cannot be used with -collections, -lock, -atomic, -track")
+		collections     = flagSet.Bool("collections", false, "generates adding and removing methods of slice and map fields ("+
+			generator.DefaultAddPrefix+", "+generator.DefaultRemovePrefix+", "+generator.DefaultPutPrefix+", "+generator.DefaultDeletePrefix+")")
+		flat   = params.Flat(flagSet)
+		flatOk = flagSet.Bool("flat-ok", false, "getters of nested struct fields return the value and whether all intermediate pointers are not nil")
+		lock   = flagSet.String("lock", "", "a sync.Mutex or sync.RWMutex field name that is locked inside accessors (RLock for getters of RWMutex)")
+		atomic = flagSet.Bool("atomic", false, "generates accessors of sync/atomic type fields that use the Load and Store methods")
+		track  = flagSet.String("track", "", "a map[string]bool or map[string]struct{} field name that setters use to mark modified fields, "+
+			"generates the field constants prefixed by the type name and the "+generator.DefaultDirtyMethod+", "+generator.DefaultIsDirtyMethod+", "+generator.DefaultResetDirtyMethod+" methods, cannot be used with -atomic")
+		accessors = flagSet.String("accessors", "get-set", "full access methods or getter or setter only (supported: get-set, get, set)")
+		nolint    = params.Nolint(flagSet)
 	)
 
 	return New(
@@ -43,6 +54,12 @@ func NewGettersSetters() *Command {
 				return fmt.Errorf("usupported accessors '%s'", *accessors)
 			}
 
+			if *noRefReceiver && *collections {
+				return fmt.Errorf("the collection methods cannot be generated with value receivers, they would modify a copy of the struct")
+			} else if *noRefReceiver && *atomic {
+				return fmt.Errorf("the atomic accessors cannot be generated with value receivers, they would copy the atomic fields")
+			}
+
 			model, err := context.StructModel()
 			if err != nil {
 				return err
@@ -53,8 +70,42 @@ func NewGettersSetters() *Command {
 				return err
 			}
 
+			access := generator.FieldAccess{}
+			if len(*lock) > 0 {
+				if *noRefReceiver {
+					return fmt.Errorf("the lock field '%s' cannot be used with value receivers", *lock)
+				}
+				lockType, ok := model.FieldsType[*lock]
+				if !ok {
+					return fmt.Errorf("lock field '%s' not found", *lock)
+				} else if len(pkgName) > 0 && !generator.IsExported(*lock) {
+					return fmt.Errorf("lock field '%s' must be exported to be accessed from the output package", *lock)
+				}
+				mutex, _ := util.GetTypeNamed(lockType.Type)
+				if mutex == nil || mutex.Obj().Pkg() == nil || mutex.Obj().Pkg().Path() != "sync" ||
+					(mutex.Obj().Name() != generator.MutexTypeName && mutex.Obj().Name() != generator.RWMutexTypeName) {
+					return fmt.Errorf("lock field '%s' is not sync.Mutex or sync.RWMutex", *lock)
+				}
+				access = generator.FieldAccess{Lock: *lock, RWLock: mutex.Obj().Name() == generator.RWMutexTypeName}
+			}
+
 			rec := generator.TypeReceiverVar(model.TypeName())
-			fmn, fmb, err := generateGettersSetters(g, model, model, pkgName, rec, *getPrefix, *setPrefix, getters, setters, !(*noRefReceiver), !(*noExportMethods), *nolint, nil)
+			var trackConsts map[string]string
+			if len(*track) > 0 {
+				if *noRefReceiver {
+					return fmt.Errorf("the track field '%s' cannot be used with value receivers", *track)
+				} else if *atomic {
+					return fmt.Errorf("the track field '%s' cannot be used with atomic accessors, atomic stores are not marked as dirty", *track)
+				} else if len(pkgName) > 0 {
+					return fmt.Errorf("the track field '%s' requires generating to the package of the type %s", *track, model.TypeName())
+				}
+				if access.Track, trackConsts, err = g.GenerateFieldTrack(model, rec, *track, access, !(*noExportMethods), *nolint); err != nil {
+					return err
+				}
+			}
+
+			fmn, fmb, err := generateGettersSetters(g, model, model, pkgName, rec, *getPrefix, *setPrefix, getters, setters, *collections, !(*noRefReceiver), !(*noExportMethods), *nolint, nil,
+				immutable.NewSet(*flat...).Contains, *flatOk, "", access, *atomic, trackConsts, struc.HandledStructs{model.Typ: model})
 			if err != nil {
 				return err
 			}
@@ -73,30 +124,44 @@ func NewGettersSetters() *Command {
 
 func generateGettersSetters(
 	g *generator.Generator, baseModel, fieldsModel *struc.Model, pkgName, receiverVar, getterPrefix, setterPrefix string,
-	getters, setters, isReceiverReference, exportMethods, nolint bool, parentFieldInfo []generator.FieldInfo,
+	getters, setters, collections, isReceiverReference, exportMethods, nolint bool, parentFieldInfo []generator.FieldInfo,
+	isFlat func(struc.FieldName) bool, flatOk bool, flatPrefix string, access generator.FieldAccess, atomic bool, trackConsts map[string]string,
+	visited struc.HandledStructs,
 ) ([]string, []string, error) {
 	logger.Debugf("generate getters, setters: receiver %s, type %s, getterPrefix %s setterPrefix %s", receiverVar, baseModel.TypeName(), getterPrefix, setterPrefix)
 	fieldMethodBodies := []string{}
 	fieldMethodNames := []string{}
 	for _, fieldName := range fieldsModel.FieldNames {
 		fieldType := fieldsModel.FieldsType[fieldName]
-		if !isAccessible("getter, setter", pkgName, fieldName, fieldType) {
+		options := fieldsModel.FieldOptions(fieldName)
+		if !isAccessible("getter, setter", pkgName, fieldName, fieldType) || options.Skip {
+			continue
+		} else if len(parentFieldInfo) == 0 && (fieldName == access.Lock || access.Track != nil && fieldName == access.Track.Field) {
 			continue
 		} else if fieldType.Embedded {
 			ebmeddedFieldMethodNames, ebmeddedFieldMethodBodies, err := generateGettersSetters(
-				g, baseModel, fieldType.Model, pkgName, receiverVar, getterPrefix, setterPrefix, getters, setters, isReceiverReference, exportMethods, nolint,
-				append(parentFieldInfo, generator.FieldInfo{Name: fieldType.Name, Type: fieldType}))
+				g, baseModel, fieldType.Model, pkgName, receiverVar, getterPrefix, setterPrefix, getters, setters, collections, isReceiverReference, options.IsExport(exportMethods), nolint,
+				append(parentFieldInfo, generator.FieldInfo{Name: fieldType.Name, Type: fieldType}), isFlat, flatOk, flatPrefix, access, atomic, trackConsts, visited)
 			if err != nil {
 				return nil, nil, err
 			}
 			fieldMethodBodies = append(fieldMethodBodies, ebmeddedFieldMethodBodies...)
 			fieldMethodNames = append(fieldMethodNames, ebmeddedFieldMethodNames...)
 		} else {
-			fullFieldType, err := g.GetFullFieldTypeName(fieldType, false)
+			fieldAccess := access
+			fieldValueType := fieldType
+			if valueType, ok := generator.AtomicValueType(fieldType.Type); ok && atomic {
+				fieldAccess.Atomic = true
+				fieldValueType.Type = valueType
+			}
+			if constName, ok := trackConsts[fieldPath(parentFieldInfo, fieldName)]; ok {
+				fieldAccess = fieldAccess.Tracked(constName, fieldType.Type)
+			}
+			fullFieldType, err := g.GetFullFieldTypeName(fieldValueType, false)
 			if err != nil {
 				return nil, nil, err
 			}
-			suffix := generator.LegalIdentName(generator.IdentName(fieldName, true))
+			suffix := flatPrefix + generator.LegalIdentName(generator.IdentName(fieldName, true))
 
 			if len(getterPrefix) == 0 || getterPrefix == generator.Autoname {
 				getterPrefix = op.IfElse(suffix == fieldName, "Get", "")
@@ -104,25 +169,69 @@ func generateGettersSetters(
 					logger.Debugf("force prefix %s for field %s; suffix == fieldName", getterPrefix, fieldName)
 				}
 			}
-			if getters {
+			exportMethods := options.IsExport(exportMethods)
+			if getters && !options.NoGetter {
 				getterName := generator.IdentName(getterPrefix+suffix, exportMethods)
+				if len(options.Getter) > 0 && len(flatPrefix) == 0 {
+					getterName = options.Getter
+				}
 				logger.Debugf("getter %s", getterName)
-				getterBody := g.GenerateGetter(baseModel, pkgName, receiverVar, getterName, fieldName, fullFieldType, nolint, isReceiverReference, parentFieldInfo)
+				getterBody := op.IfElse(len(flatPrefix) > 0 && flatOk, g.GenerateGetterOk, g.GenerateGetter)(
+					baseModel, pkgName, receiverVar, getterName, fieldName, fullFieldType, nolint, isReceiverReference, parentFieldInfo, fieldAccess)
 				fieldMethodBodies = append(fieldMethodBodies, getterBody)
 				fieldMethodNames = append(fieldMethodNames, getterName)
 			}
-			if setters {
+			if setters && !options.NoSetter {
 				setterName := generator.IdentName(setterPrefix+suffix, exportMethods)
+				if len(options.Setter) > 0 {
+					setterName = options.Setter
+				}
 				logger.Debugf("setter %s", setterName)
-				setterBody := g.GenerateSetter(baseModel, pkgName, receiverVar, setterName, fieldName, fullFieldType, nolint, isReceiverReference, parentFieldInfo)
+				setterBody := g.GenerateSetter(baseModel, pkgName, receiverVar, setterName, fieldName, fullFieldType, nolint, isReceiverReference, parentFieldInfo, fieldAccess)
 				fieldMethodBodies = append(fieldMethodBodies, setterBody)
 				fieldMethodNames = append(fieldMethodNames, setterName)
 			}
+			if setters && collections && !options.NoSetter {
+				collectionMethodNames, collectionMethodBodies, err := g.GenerateCollectionSetters(baseModel, pkgName, receiverVar, fieldName,
+					fieldType.Type, fullFieldType, exportMethods, nolint, isReceiverReference, parentFieldInfo, fieldAccess)
+				if err != nil {
+					return nil, nil, err
+				}
+				fieldMethodBodies = append(fieldMethodBodies, collectionMethodBodies...)
+				fieldMethodNames = append(fieldMethodNames, collectionMethodNames...)
+			}
+			if fieldType.Model != nil && getters && isFlat(fieldName) {
+				if _, ok := visited[fieldType.Model.Typ]; ok {
+					logger.Debugf("recursive type %s of field %s is not flattened", fieldType.Model.TypeName(), fieldName)
+					continue
+				}
+				subvisited := maps.Clone(visited)
+				subvisited[fieldType.Model.Typ] = fieldType.Model
+				// only getters of nested struct fields are generated, they are nil safe along the path
+				nestedGetterPrefix := op.IfElse(len(getterPrefix) == 0 || getterPrefix == generator.Autoname, "Get", getterPrefix)
+				nestedFieldMethodNames, nestedFieldMethodBodies, err := generateGettersSetters(
+					g, baseModel, fieldType.Model, pkgName, receiverVar, nestedGetterPrefix, setterPrefix, getters, false, false, isReceiverReference, exportMethods, nolint,
+					append(parentFieldInfo, generator.FieldInfo{Name: fieldName, Type: fieldType}), isFlat, flatOk,
+					flatPrefix+generator.LegalIdentName(generator.IdentName(fieldName, true)), access, atomic, nil, subvisited)
+				if err != nil {
+					return nil, nil, err
+				}
+				fieldMethodBodies = append(fieldMethodBodies, nestedFieldMethodBodies...)
+				fieldMethodNames = append(fieldMethodNames, nestedFieldMethodNames...)
+			}
 		}
 	}
 	return fieldMethodNames, fieldMethodBodies, nil
 }
 
+func fieldPath(parentFieldInfo []generator.FieldInfo, fieldName struc.FieldName) string {
+	path := ""
+	for _, parent := range parentFieldInfo {
+		path += parent.Name + "."
+	}
+	return path + fieldName
+}
+
 func isAccessible(code string, pkgName string, fieldName struc.FieldName, fieldType struc.FieldType) bool {
 	if len(pkgName) > 0 {
 		if !generator.IsExported(fieldName) {
@@ -0,0 +1,86 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"go/types"
+	"regexp"
+
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/logger"
+	"github.com/m4gshm/fieldr/model/iface"
+	"github.com/m4gshm/fieldr/params"
+	"github.com/m4gshm/fieldr/use"
+)
+
+func NewInterface() *Command {
+	const (
+		cmdName = "interface"
+	)
+	var (
+		flagSet        = flag.NewFlagSet(cmdName, flag.ExitOnError)
+		name           = flagSet.String("name", generator.Autoname, "interface type name, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultInterfaceSuffix+" as default)")
+		include        = flagSet.String("include", "", "a regexp that method names must match")
+		exclude        = flagSet.String("exclude", "", "a regexp of excluded method names")
+		withUnexported = flagSet.Bool("with-unexported", false, "use unexported methods")
+		noRefReceiver  = flagSet.Bool("no-ref", false, "use the method set of the value type (not pointer)")
+		nolint         = params.Nolint(flagSet)
+	)
+
+	return New(
+		cmdName, "generates an interface based on the method set of a type",
+		flagSet,
+		func(context *Context) error {
+			typ := context.Typ
+			if typ == nil {
+				logger.Debugf("error config without type")
+				return use.Err("no type in context")
+			}
+			includeExpr, err := compileMethodFilter("include", *include)
+			if err != nil {
+				return err
+			}
+			excludeExpr, err := compileMethodFilter("exclude", *exclude)
+			if err != nil {
+				return err
+			}
+			typeMethods, err := iface.TypeMethods(typ, !(*noRefReceiver))
+			if err != nil {
+				return err
+			}
+			g := context.Generator
+			methods := slice.Filter(typeMethods, func(method *types.Func) bool {
+				methodName := method.Name()
+				return (*withUnexported || method.Exported()) &&
+					(includeExpr == nil || includeExpr.MatchString(methodName)) &&
+					(excludeExpr == nil || !excludeExpr.MatchString(methodName))
+			})
+			if len(methods) == 0 {
+				return fmt.Errorf("no methods of '%s' selected", typ.Obj().Name())
+			}
+			for _, method := range methods {
+				if pkg := method.Pkg(); !method.Exported() && pkg.Path() != g.OutPkgPath {
+					return fmt.Errorf("unexported method '%s' cannot be declared outside the package %s", method.Name(), pkg.Path())
+				}
+			}
+			s, err := g.GenerateInterface(typ, *name, methods, *nolint)
+			if err != nil {
+				return err
+			}
+			return g.AddStruct(s)
+		},
+	)
+}
+
+func compileMethodFilter(name, expr string) (*regexp.Regexp, error) {
+	if len(expr) == 0 {
+		return nil, nil
+	}
+	compiled, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("%s methods regexp: %w", name, err)
+	}
+	return compiled, nil
+}
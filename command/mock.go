@@ -0,0 +1,42 @@
+package command
+
+import (
+	"flag"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/params"
+)
+
+func NewMock() *Command {
+	const (
+		cmdName = "mock"
+	)
+	var (
+		flagSet    = flag.NewFlagSet(cmdName, flag.ExitOnError)
+		name       = flagSet.String("name", generator.Autoname, "mock type name, use "+generator.Autoname+" for autoname (<Type name>"+generator.DefaultMockSuffix+" as default)")
+		funcSuffix = flagSet.String("func-suffix", generator.DefaultMockFuncSuffix, "suffix of the function fields that implement the interface methods")
+		nolint     = params.Nolint(flagSet)
+	)
+
+	return New(
+		cmdName, "generates a mock struct of the interface type that records calls",
+		flagSet,
+		func(context *Context) error {
+			model, err := context.InterfaceModel()
+			if err != nil {
+				return err
+			}
+			g := context.Generator
+			structs, err := g.GenerateMock(model, *name, *funcSuffix, *nolint)
+			if err != nil {
+				return err
+			}
+			for _, s := range structs {
+				if err := g.AddStruct(s); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+}
@@ -22,6 +22,7 @@ func NewNewFull() *Command {
 		flat            = flagSet.Bool("flat", false, "makes fields of emmbedded types constructor arguments")
 		noinine         = flagSet.Bool("no-inline", false, "no inlines empty embedded structs")
 		exclude         = params.MultiValFixed(flagSet, "exclude", nil, nil, "excluded argument")
+		defaultValues   = params.Default(flagSet)
 		nolint          = params.Nolint(flagSet)
 	)
 	return New(
@@ -35,9 +36,15 @@ func NewNewFull() *Command {
 			if name != nil && len(*name) > 0 {
 				g := context.Generator
 				isInclude := is.Not(immutable.NewSet(*exclude...).Contains)
-				cname, body, err := constructor.FullArgs(g, model, *name, *returnVal, !(*noExportMethods), *nolint, *flat, *noinine, isInclude)
+				defaults, err := constructor.NewDefaults(g, *defaultValues)
 				if err != nil {
 					return err
+				}
+				cname, body, err := constructor.FullArgs(g, model, *name, *returnVal, !(*noExportMethods), *nolint, *flat, *noinine, isInclude, defaults)
+				if err != nil {
+					return err
+				} else if err := defaults.CheckFound(); err != nil {
+					return err
 				} else if err := g.AddFuncOrMethod(cname, body); err != nil {
 					return err
 				}
@@ -2,6 +2,9 @@ package command
 
 import (
 	"flag"
+	"fmt"
+	"go/types"
+	"strings"
 
 	"github.com/m4gshm/gollections/collection"
 	"github.com/m4gshm/gollections/collection/immutable"
@@ -33,6 +36,10 @@ func NewNewOpt() *Command {
 		noinine         = flagSet.Bool("no-inline", false, "no inlines empty embedded structs")
 		nolint          = params.Nolint(flagSet)
 		required        = params.MultiValFixed(flagSet, "required", nil, nil, "required argument")
+		defaultValues   = params.Default(flagSet)
+		returnErr       = flagSet.Bool("error", false, "generates options returning an error and the constructor returning the created instance and an error")
+		optionType      = flagSet.String("option-type", "", "generates the option interface type with this name and an option struct per field instead of option functions, use "+generator.Autoname+" for autoname <Type name>"+generator.DefaultOptionTypeSuffix)
+		validate        = params.MultiVal(flagSet, "validate", []string{}, "a field validation function in the form <Field>=<function name>, the function 'func(<field type>) error' is called by the option of the field, requires -error")
 	)
 
 	return New(
@@ -48,25 +55,78 @@ func NewNewOpt() *Command {
 			if err != nil {
 				return err
 			}
-			requird := immutable.NewSet(*required...)
+			requird := immutable.NewSet(append(requiredFieldNames(model, func(fieldsModel *struc.Model, fieldName struc.FieldName) bool {
+				return fieldsModel.FieldOptions(fieldName).Required
+			}), *required...)...)
+			validators := map[struc.FieldName]string{}
+			for _, v := range *validate {
+				fieldName, funcName, ok := strings.Cut(v, struc.ReplaceableValueSeparator)
+				if fieldName, funcName = strings.TrimSpace(fieldName), strings.TrimSpace(funcName); !ok || len(fieldName) == 0 || len(funcName) == 0 {
+					return fmt.Errorf("invalid validation function '%s', expected <Field>%s<function name>", v, struc.ReplaceableValueSeparator)
+				} else if requird.Contains(fieldName) {
+					return fmt.Errorf("required field '%s' has no option to validate", fieldName)
+				}
+				validators[fieldName] = funcName
+			}
+			if len(validators) > 0 && !(*returnErr) {
+				return fmt.Errorf("validation functions require the -error flag")
+			}
+			params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
+			typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
+			optionTypeName := *optionType
+			if optionTypeName == generator.Autoname {
+				optionTypeName = generator.IdentName(model.TypeName()+generator.DefaultOptionTypeSuffix, !(*noExportMethods))
+			}
+			optionArgType := "func(*" + model.TypeName() + typeParams + ")" + op.IfElse(*returnErr, " error", "")
+			if len(optionTypeName) > 0 {
+				optionArgType = optionTypeName + typeParams
+				if err := g.AddStruct(generator.Structure{
+					Name: optionTypeName,
+					Body: optionTypeName + typeParamsDecl + " interface {" + generator.NoLint(*nolint) + "\n" +
+						generator.DefaultOptionApplyMethod + "(*" + generator.GetTypeName(model.TypeName(), pkgName) + typeParams + ")" +
+						op.IfElse(*returnErr, " error", "") + "\n}\n",
+				}); err != nil {
+					return err
+				}
+			}
+			applyOpt := "opt"
+			if len(optionTypeName) > 0 {
+				applyOpt += "." + generator.DefaultOptionApplyMethod
+			}
 			if !(*noConstructor) {
 				uniqueNames := unique.NewNamesWith(unique.DistinctBySuffix("_"))
-				params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
-				typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
 				slice.ForEach(paramNames, uniqueNames.Add)
 
+				defaults, err := constructor.NewDefaults(g, *defaultValues)
+				if err != nil {
+					return err
+				}
 				args, createInstance, err := constructor.GenerateConstructorArgs(g, uniqueNames, "", model.TypeName(),
-					typeParams, model.FieldsNameAndType, *returnVal, *flat, *noinine, requird.Contains)
+					typeParams, model, *returnVal, *flat, *noinine, requird.Contains, defaults)
 				if err != nil {
 					return err
+				} else if err := defaults.CheckFound(); err != nil {
+					return err
+				}
+				arguments := args + "opts... " + optionArgType + op.IfElse(len(args) > 0, ",\n", "")
+				var constrName, constructorBody string
+				if *returnErr {
+					errVar := uniqueNames.Get("err")
+					constrName, constructorBody = constructor.NewFallible(*name, model.TypeName(), typeParamsDecl,
+						typeParams, uniqueNames.Get("r"), *returnVal, !(*noExportMethods), *nolint,
+						arguments, createInstance,
+						func(receiver, failure string) string {
+							return "for _, opt := range opts {\nif " + errVar + " := " + applyOpt + "(" + op.IfElse(*returnVal, "&", "") + receiver + "); " + errVar + " != nil {\n" +
+								"return " + failure + ", " + errVar + "\n}\n}"
+						})
+				} else {
+					constrName, constructorBody = constructor.New(*name, model.TypeName(), typeParamsDecl,
+						typeParams, uniqueNames.Get("r"), *returnVal, !(*noExportMethods), *nolint,
+						arguments, createInstance,
+						func(receiver string) string {
+							return "for _, opt := range opts {\n" + applyOpt + "(" + op.IfElse(*returnVal, "&", "") + receiver + ")\n}"
+						})
 				}
-				arguments := args + "opts... func(*" + model.TypeName() + typeParams + ")" + op.IfElse(len(args) > 0, ",\n", "")
-				constrName, constructorBody := constructor.New(*name, model.TypeName(), typeParamsDecl,
-					typeParams, uniqueNames.Get("r"), *returnVal, !(*noExportMethods), *nolint,
-					arguments, createInstance,
-					func(receiver string) string {
-						return "for _, opt := range opts {\nopt(" + op.IfElse(*returnVal, "&", "") + receiver + ")\n}"
-					})
 				if err := g.AddFuncOrMethod(constrName, constructorBody); err != nil {
 					return err
 				}
@@ -75,15 +135,35 @@ func NewNewOpt() *Command {
 			if suffix != nil && *suffix == generator.Autoname {
 				*suffix = model.TypeName()
 			}
-			fieldMethods, err := generateOptionFuncs(g, model, model, pkgName, rec, *suffix, !(*noExportMethods), *nolint, nil, requird.Contains)
+			validated := map[struc.FieldName]bool{}
+			validator := func(fieldName struc.FieldName, fieldType types.Type) (string, error) {
+				funcName, ok := validators[fieldName]
+				if !ok {
+					return "", nil
+				}
+				validated[fieldName] = true
+				return g.ValidatorFuncExpr(model, fieldName, fieldType, funcName)
+			}
+			fieldMethods, optionStructs, err := generateOptionFuncs(g, model, model, pkgName, rec, *suffix, !(*noExportMethods), *nolint, nil, requird.Contains,
+				*returnErr, validator, optionTypeName)
 			if err != nil {
 				return err
 			}
+			for fieldName := range validators {
+				if !validated[fieldName] {
+					return fmt.Errorf("validated field '%s' not found", fieldName)
+				}
+			}
 			for fieldMethodName, fieldMethodBody := range fieldMethods.All {
 				if err := g.AddFuncOrMethod(fieldMethodName, fieldMethodBody); err != nil {
 					return err
 				}
 			}
+			for _, optionStruct := range optionStructs {
+				if err := g.AddStruct(optionStruct); err != nil {
+					return err
+				}
+			}
 			return nil
 		},
 	)
@@ -92,31 +172,49 @@ func NewNewOpt() *Command {
 func generateOptionFuncs(
 	g *generator.Generator, baseModel, fieldsModel *struc.Model, pkgName, receiverVar, suffix string,
 	exportMethods, nolint bool, parentFieldInfo []generator.FieldInfo, isExclude func(struc.FieldName) bool,
-) (collection.Map[string, string], error) {
+	returnErr bool, validator func(struc.FieldName, types.Type) (string, error), optionType string,
+) (collection.Map[string, string], []generator.Structure, error) {
 	logger.Debugf("generate option function: receiver %s, type %s, suffix %s", receiverVar, baseModel.TypeName(), suffix)
 	fieldMethods := mutable.NewMapOrdered[string, string]()
+	optionStructs := []generator.Structure{}
 	for fieldName, fieldType := range fieldsModel.FieldsNameAndType {
-		if !isAccessible("option function", pkgName, fieldName, fieldType) {
+		options := fieldsModel.FieldOptions(fieldName)
+		if !isAccessible("option function", pkgName, fieldName, fieldType) || options.Skip {
 			continue
 		} else if fieldType.Embedded {
-			embeddedFieldMethods, err := generateOptionFuncs(
-				g, baseModel, fieldType.Model, pkgName, receiverVar, suffix, exportMethods, nolint,
-				append(parentFieldInfo, generator.FieldInfo{Name: fieldType.Name, Type: fieldType}), isExclude)
+			embeddedFieldMethods, embeddedOptionStructs, err := generateOptionFuncs(
+				g, baseModel, fieldType.Model, pkgName, receiverVar, suffix, options.IsExport(exportMethods), nolint,
+				append(parentFieldInfo, generator.FieldInfo{Name: fieldType.Name, Type: fieldType}), isExclude, returnErr, validator, optionType)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			fieldMethods.SetMap(embeddedFieldMethods)
+			optionStructs = append(optionStructs, embeddedOptionStructs...)
 		} else if !isExclude(fieldName) {
 			fullFieldType, err := g.GetFullFieldTypeName(fieldType, false)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
+			}
+			validatorFunc, err := validator(fieldName, fieldType.Type)
+			if err != nil {
+				return nil, nil, err
 			}
-			funcName := generator.IdentName(suffix+generator.LegalIdentName(generator.IdentName(fieldName, true)), exportMethods)
+			funcName := generator.IdentName(suffix+generator.LegalIdentName(generator.IdentName(fieldName, true)), options.IsExport(exportMethods))
 			logger.Debugf("option function name: %s", funcName)
-			funcBody := g.GenerateOptionFieldFunc(baseModel, pkgName, receiverVar, funcName, fieldName, fullFieldType,
-				g.OutPkgPath, nolint, parentFieldInfo)
-			fieldMethods.Set(funcName, funcBody)
+			if len(optionType) > 0 {
+				funcBody, optionStruct, err := g.GenerateOptionFieldStruct(baseModel, pkgName, receiverVar, funcName, fieldName, fullFieldType,
+					optionType, generator.DefaultOptionApplyMethod, g.OutPkgPath, nolint, parentFieldInfo, returnErr, validatorFunc)
+				if err != nil {
+					return nil, nil, err
+				}
+				fieldMethods.Set(funcName, funcBody)
+				optionStructs = append(optionStructs, optionStruct)
+			} else {
+				funcBody := g.GenerateOptionFieldFunc(baseModel, pkgName, receiverVar, funcName, fieldName, fullFieldType,
+					g.OutPkgPath, nolint, parentFieldInfo, returnErr, validatorFunc)
+				fieldMethods.Set(funcName, funcBody)
+			}
 		}
 	}
-	return fieldMethods, nil
+	return fieldMethods, optionStructs, nil
 }
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/m4gshm/flag/flagenum"
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/collection/mutable/ordered/set"
+	"github.com/m4gshm/gollections/slice"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/logger"
+	"github.com/m4gshm/fieldr/model/iface"
+	"github.com/m4gshm/fieldr/model/util"
+	"github.com/m4gshm/fieldr/params"
+)
+
+func NewSealed() *Command {
+	const (
+		name = "sealed"
+	)
+	type apiMethod string
+	const (
+		markerMeth apiMethod = "marker"
+		visitFunc  apiMethod = "visit"
+		jsonFuncs  apiMethod = "json"
+	)
+	var (
+		flagSet           = flag.NewFlagSet(name, flag.ExitOnError)
+		variantNames      = params.MultiVal(flagSet, "variant", []string{}, "a variant type name; all types of the interface package that implement the interface are used by default")
+		markerMethodName  = flagSet.String("marker", generator.Autoname, "an unexported marker method name that should be declared in the interface, use "+generator.Autoname+" for autoname ("+generator.DefaultSealedMarkerPrefix+"<Type name> as default)")
+		visitFuncName     = flagSet.String("visit", generator.Autoname, "a function name that calls a callback of the variant, use "+generator.Autoname+" for autoname ("+generator.DefaultSealedVisitPrefix+"<Type name> as default)")
+		marshalFuncName   = flagSet.String("marshal", generator.Autoname, "a function name that marshals a variant to JSON with the discriminator, use "+generator.Autoname+" for autoname ("+generator.DefaultSealedMarshalPrefix+"<Type name>"+generator.DefaultSealedJSONSuffix+" as default)")
+		unmarshalFuncName = flagSet.String("unmarshal", generator.Autoname, "a function name that unmarshals a variant from JSON by the discriminator, use "+generator.Autoname+" for autoname ("+generator.DefaultSealedUnmarshalPrefix+"<Type name>"+generator.DefaultSealedJSONSuffix+" as default)")
+		discriminator     = flagSet.String("discriminator", "type", "the JSON property name that contains the variant type name")
+		export            = params.Export(flagSet)
+		nolint            = params.Nolint(flagSet)
+	)
+	defaultApis := slice.Of(markerMeth, visitFunc, jsonFuncs)
+	apis, err := flagenum.Multiple(flagSet, "api", defaultApis, defaultApis, fromString[apiMethod], toString[apiMethod], "generated api methods or functions")
+	if err != nil {
+		panic(err)
+	}
+
+	c := New(
+		name, "generates tagged union helpers for an interface implemented by a closed set of types",
+		flagSet,
+		func(context *Context) error {
+			if len(*discriminator) == 0 || *discriminator == "-" || strings.ContainsAny(*discriminator, "\"`\\,") {
+				return fmt.Errorf("invalid discriminator '%s'", *discriminator)
+			}
+			g := context.Generator
+			model, err := context.InterfaceModel()
+			if err != nil {
+				return err
+			} else if model.Typ().TypeParams().Len() > 0 {
+				return fmt.Errorf("generic interface '%s' is not supported", model.TypeName())
+			}
+			variants, err := findSealedVariants(context, model, generator.SealedMarkerName(model.TypeName(), *markerMethodName), *variantNames)
+			if err != nil {
+				return err
+			} else if len(variants) == 0 {
+				return fmt.Errorf("no variants of '%s' found", model.TypeName())
+			}
+			selectedApis := immutable.NewSet(*apis...)
+			if selectedApis.Contains(markerMeth) {
+				for _, variant := range variants {
+					funcName, funcBody, err := g.GenerateSealedMarker(model, variant, *markerMethodName, *nolint)
+					if err != nil {
+						return err
+					} else if len(funcName) == 0 {
+						continue
+					} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
+						return err
+					}
+				}
+			}
+			if selectedApis.Contains(visitFunc) {
+				funcName, funcBody, err := g.GenerateSealedVisit(model, variants, *visitFuncName, *export, *nolint)
+				if err != nil {
+					return err
+				} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+			}
+			if selectedApis.Contains(jsonFuncs) {
+				funcName, funcBody, err := g.GenerateSealedMarshalJSON(model, variants, *marshalFuncName, *discriminator, *export, *nolint)
+				if err != nil {
+					return err
+				} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+				funcName, funcBody, err = g.GenerateSealedUnmarshalJSON(model, variants, *unmarshalFuncName, *discriminator, *export, *nolint)
+				if err != nil {
+					return err
+				} else if err = g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	c.manual = `Example:
+	type Shape interface {
+		Area() float64
+		isShape()
+	}
+
+The marker method 'isShape' is generated for all struct types of the Shape package that implement the rest of the interface methods,
+variants that already declare the marker method are not changed. The variants of an interface with the marker method only must be set by -variant.`
+	return c
+}
+
+// findSealedVariants returns the interface variants declared in the interface package; the marker method is not required to be implemented.
+func findSealedVariants(context *Context, model *iface.Model, marker string, variantNames []string) ([]iface.Variant, error) {
+	ifacePkg := model.Package()
+	pkgs := set.Of[*packages.Package]()
+	for pkg := range context.Packages.All {
+		if pkg.Types == ifacePkg {
+			pkgs.Add(pkg)
+		}
+	}
+	if len(variantNames) == 0 {
+		if len(model.RequiredMethods(marker)) == 0 {
+			return nil, fmt.Errorf("'%s' declares the marker method '%s' only, the variants must be set by the -variant flag", model.TypeName(), marker)
+		}
+		variants := []iface.Variant{}
+		for pkg := range pkgs.All {
+			variants = append(variants, model.Implementations(pkg.Types.Scope(), marker)...)
+		}
+		logger.Debugf("found %d variants of %s", len(variants), model.TypeName())
+		return variants, nil
+	}
+	variants := make([]iface.Variant, 0, len(variantNames))
+	for _, variantName := range variantNames {
+		typ, _, _, _, err := util.FindTypePackageFile(variantName, context.FileSet, pkgs)
+		if err != nil {
+			return nil, fmt.Errorf("find variant %s: %w", variantName, err)
+		} else if typ == nil {
+			return nil, fmt.Errorf("variant '%s' not found in the package %s", variantName, ifacePkg.Path())
+		} else if typ.TypeParams().Len() > 0 {
+			return nil, fmt.Errorf("generic variant '%s' is not supported", variantName)
+		} else if variant, ok := model.Implements(typ, marker); !ok {
+			return nil, fmt.Errorf("variant '%s' does not implement '%s'", variantName, model.TypeName())
+		} else {
+			variants = append(variants, variant)
+		}
+	}
+	return variants, nil
+}
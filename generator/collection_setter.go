@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"go/types"
+
+	"github.com/m4gshm/fieldr/model/util"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const (
+	DefaultAddPrefix    = "Add"
+	DefaultRemovePrefix = "Remove"
+	DefaultPutPrefix    = "Put"
+	DefaultDeletePrefix = "Delete"
+)
+
+// CollectionMethod is an adding or removing method of a slice or map field.
+type CollectionMethod struct {
+	Prefix, Params string
+	// Body returns the method statements that modify the field.
+	Body func(field string) string
+}
+
+// CollectionMethods returns the adding and removing methods of a slice or map field, returns nothing for other field types.
+// The remove method is returned for comparable slice elements only.
+func (g *Generator) CollectionMethods(fieldType types.Type, fullFieldType string, uniqueNames *unique.Names) ([]CollectionMethod, error) {
+	switch collection := fieldType.Underlying().(type) {
+	case *types.Slice:
+		elem, err := g.repackedTypeString(collection.Elem())
+		if err != nil {
+			return nil, err
+		}
+		items := uniqueNames.Get("items")
+		methods := []CollectionMethod{{Prefix: DefaultAddPrefix, Params: items + " ..." + elem, Body: func(field string) string {
+			return field + " = append(" + field + ", " + items + "...)\n"
+		}}}
+		if types.Comparable(collection.Elem()) {
+			slicesPkg, err := g.GetPackageNameOrAlias("slices", "slices")
+			if err != nil {
+				return nil, err
+			}
+			item := uniqueNames.Get("item")
+			e := uniqueNames.Get("e")
+			methods = append(methods, CollectionMethod{Prefix: DefaultRemovePrefix, Params: item + " " + elem, Body: func(field string) string {
+				return field + " = " + GetTypeName("DeleteFunc", slicesPkg) + "(" + field + ", func(" + e + " " + elem + ") bool { return " + e + " == " + item + " })\n"
+			}})
+		}
+		return methods, nil
+	case *types.Map:
+		key, err := g.repackedTypeString(collection.Key())
+		if err != nil {
+			return nil, err
+		}
+		value, err := g.repackedTypeString(collection.Elem())
+		if err != nil {
+			return nil, err
+		}
+		k := uniqueNames.Get("key")
+		v := uniqueNames.Get("value")
+		return []CollectionMethod{
+			{Prefix: DefaultPutPrefix, Params: k + " " + key + ", " + v + " " + value, Body: func(field string) string {
+				return "if " + field + " == nil {\n" + field + " = make(" + fullFieldType + ")\n}\n" + field + "[" + k + "] = " + v + "\n"
+			}},
+			{Prefix: DefaultDeletePrefix, Params: k + " " + key, Body: func(field string) string {
+				return "delete(" + field + ", " + k + ")\n"
+			}},
+		}, nil
+	}
+	return nil, nil
+}
+
+func (g *Generator) repackedTypeString(typ types.Type) (string, error) {
+	repacked, err := g.Repack(typ, g.OutPkgPath)
+	if err != nil {
+		return "", err
+	}
+	return util.TypeString(repacked, g.OutPkgPath), nil
+}
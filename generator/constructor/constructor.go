@@ -5,8 +5,6 @@ import (
 	"github.com/m4gshm/gollections/op"
 	"github.com/m4gshm/gollections/op/delay/sum"
 	"github.com/m4gshm/gollections/predicate/always"
-	"github.com/m4gshm/gollections/seq"
-	"github.com/m4gshm/gollections/seq2"
 	"github.com/m4gshm/gollections/slice"
 
 	"github.com/m4gshm/fieldr/generator"
@@ -45,8 +43,27 @@ func New(
 	return constructorName, body
 }
 
+// NewFallible generates a constructor that returns the created instance and an error of the init part.
+// The init part returns the failure expression in case of an error.
+func NewFallible(
+	name, typeName, typeParamsDecl, typeParams, receiver string,
+	returnVal, exportMethods, nolint bool,
+	arguments, createInstance string, init func(receiver, failure string) string,
+) (string, string) {
+	constructorName := generator.IdentName(get.If(name == generator.Autoname, sum.Of("New", typeName)).Else(name), exportMethods)
+	failure := op.IfElse(returnVal, typeName+typeParams+"{}", "nil")
+
+	body := "func " + constructorName + typeParamsDecl + "(" + op.IfElse(len(arguments) > 0, "\n", "") + arguments + ") (" + op.IfElse(returnVal, "", "*") +
+		typeName + typeParams + ", error) {" + generator.NoLint(nolint) + "\n" +
+		receiver + " := " + createInstance + "\n" +
+		init(receiver, failure) + "\n" +
+		"return " + receiver + ", nil\n" +
+		"}\n"
+	return constructorName, body
+}
+
 func FullArgs(g *generator.Generator, model *struc.Model, constructorName string, returnVal, exportMethods, nolint, flat, noInline bool,
-	isInclude func(element string) (ok bool),
+	isInclude func(element string) (ok bool), defaults *Defaults,
 ) (string, string, error) {
 	uniqueNames := unique.NewNamesWith(unique.DistinctBySuffix("_"))
 
@@ -56,8 +73,7 @@ func FullArgs(g *generator.Generator, model *struc.Model, constructorName string
 
 	typeName := model.TypeName()
 
-	fields := model.FieldsNameAndType
-	args, createInstance, err := GenerateConstructorArgs(g, uniqueNames, "", typeName, typeParams, fields, returnVal, flat, noInline, isInclude)
+	args, createInstance, err := GenerateConstructorArgs(g, uniqueNames, "", typeName, typeParams, model, returnVal, flat, noInline, isInclude, defaults)
 	if err != nil {
 		return "", "", err
 	}
@@ -65,15 +81,16 @@ func FullArgs(g *generator.Generator, model *struc.Model, constructorName string
 	return name, body, nil
 }
 
-var noFields = seq2.Of[struc.FieldName, struc.FieldType]()
-
 func GenerateConstructorArgs(
 	g *generator.Generator, uniqueNames *unique.Names, typePkg, typeName string, typeParams string,
-	fields seq.Seq2[struc.FieldName, struc.FieldType],
-	returnVal, flat, noInline bool, isInclude func(struc.FieldName) bool,
+	model *struc.Model,
+	returnVal, flat, noInline bool, isInclude func(struc.FieldName) bool, defaults *Defaults,
 ) (string, string, error) {
 	var args, initInstace string
-	for fieldName, fieldType := range op.IfElse(fields != nil, fields, noFields) {
+	for fieldName, fieldType := range model.FieldsNameAndType {
+		if model.FieldOptions(fieldName).Skip {
+			continue
+		}
 		fieldModel := fieldType.Model
 
 		deepRef := fieldType.RefDeep > 1
@@ -93,7 +110,7 @@ func GenerateConstructorArgs(
 				return "", "", err
 			}
 			eargs, eCreateInstance, err := GenerateConstructorArgs(g, uniqueNames, typePkgName, typeName, typeParams,
-				fieldModel.FieldsNameAndType, val, flat, noInline, isInclude)
+				fieldModel, val, flat, noInline, isInclude, defaults)
 			if err != nil {
 				return "", "", err
 			}
@@ -108,7 +125,7 @@ func GenerateConstructorArgs(
 					}
 					typeName := fieldModel.TypeName()
 					_, eCreateInstance, err := GenerateConstructorArgs(g, uniqueNames, typePkgName, typeName, typeParams,
-						fieldModel.FieldsNameAndType, val, false, noInline, always.True)
+						fieldModel, val, false, noInline, always.True, nil)
 					if err != nil {
 						return "", "", err
 					}
@@ -123,6 +140,12 @@ func GenerateConstructorArgs(
 				args += argName + " " + fullFieldType + ",\n"
 				initInstace += fieldName + ":" + argName + ",\n"
 			}
+		} else {
+			if value, ok, err := defaults.Get(model, fieldName, fieldType); err != nil {
+				return "", "", err
+			} else if ok {
+				initInstace += fieldName + ":" + value + ",\n"
+			}
 		}
 	}
 	createInstance := op.IfElse(returnVal, "", "&") + typePkg + op.IfElse(len(typePkg) > 0, ".", "") +
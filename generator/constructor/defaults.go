@@ -0,0 +1,63 @@
+package constructor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/m4gshm/fieldr/generator"
+	"github.com/m4gshm/fieldr/model/struc"
+)
+
+// DefaultTag is the struct tag that contains a Go expression of the field default value.
+const DefaultTag = "default"
+
+// Defaults provides default values of fields defined by the flag values or the field tags.
+type Defaults struct {
+	g      *generator.Generator
+	byFlag map[struc.FieldName]string
+	found  map[struc.FieldName]bool
+}
+
+// NewDefaults parses flag values in the form <Field>=<Go expression>.
+func NewDefaults(g *generator.Generator, flagValues []string) (*Defaults, error) {
+	byFlag := map[struc.FieldName]string{}
+	for _, flagValue := range flagValues {
+		fieldName, expr, ok := strings.Cut(flagValue, struc.ReplaceableValueSeparator)
+		if fieldName, expr = strings.TrimSpace(fieldName), strings.TrimSpace(expr); !ok || len(fieldName) == 0 || len(expr) == 0 {
+			return nil, fmt.Errorf("invalid default value '%s', expected <Field>%s<expression>", flagValue, struc.ReplaceableValueSeparator)
+		}
+		byFlag[fieldName] = expr
+	}
+	return &Defaults{g: g, byFlag: byFlag, found: map[struc.FieldName]bool{}}, nil
+}
+
+// Get returns the type-checked default value expression of the field if it is defined.
+func (d *Defaults) Get(model *struc.Model, fieldName struc.FieldName, fieldType struc.FieldType) (string, bool, error) {
+	if d == nil {
+		return "", false, nil
+	}
+	expr, ok := d.byFlag[fieldName]
+	if ok {
+		d.found[fieldName] = true
+	} else if expr, ok = model.FieldsTagValue[fieldName][DefaultTag]; !ok {
+		return "", false, nil
+	}
+	value, err := d.g.DefaultValueExpr(model, fieldName, fieldType.Type, expr)
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+// CheckFound returns an error if a default value of the flag values is not applied to any field.
+func (d *Defaults) CheckFound() error {
+	if d == nil {
+		return nil
+	}
+	for fieldName := range d.byFlag {
+		if !d.found[fieldName] {
+			return fmt.Errorf("default value of field '%s' is not applied, the field is not found or is not optional", fieldName)
+		}
+	}
+	return nil
+}
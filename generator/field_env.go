@@ -0,0 +1,119 @@
+package generator
+
+import (
+	"go/token"
+	"go/types"
+
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
+)
+
+// Field type kinds of the 'field.kind' expression property.
+const (
+	KindBasic     = "basic"
+	KindPointer   = "pointer"
+	KindSlice     = "slice"
+	KindArray     = "array"
+	KindMap       = "map"
+	KindChan      = "chan"
+	KindStruct    = "struct"
+	KindInterface = "interface"
+	KindFunc      = "func"
+	KindTypeParam = "typeparam"
+)
+
+// structEnv returns the 'struct' property of the fields-to-consts expressions environment.
+func structEnv(model *struc.Model, outPkgPath string) map[string]any {
+	tparams := model.Typ.TypeParams()
+	typeParams := make([]string, tparams.Len())
+	for i := range typeParams {
+		typeParams[i] = tparams.At(i).Obj().Name()
+	}
+	return map[string]any{
+		"name":       model.TypeName(),
+		"type":       model.TypeNameFull(outPkgPath),
+		"typeParams": typeParams,
+	}
+}
+
+// fieldEnv returns the 'field' property of the fields-to-consts expressions environment.
+func fieldEnv(model *struc.Model, outPkgPath, path, fullPath string, fieldName struc.FieldName, fieldType struc.FieldType) map[string]any {
+	typ := fieldType.Type
+	refDeep := 0
+	base := typ
+	for {
+		pointer, ok := base.(*types.Pointer)
+		if !ok {
+			break
+		}
+		refDeep++
+		base = pointer.Elem()
+	}
+	var elem, key string
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		elem = util.TypeString(t.Elem(), outPkgPath)
+	case *types.Slice:
+		elem = util.TypeString(t.Elem(), outPkgPath)
+	case *types.Array:
+		elem = util.TypeString(t.Elem(), outPkgPath)
+	case *types.Chan:
+		elem = util.TypeString(t.Elem(), outPkgPath)
+	case *types.Map:
+		key, elem = util.TypeString(t.Key(), outPkgPath), util.TypeString(t.Elem(), outPkgPath)
+	}
+	index := -1
+	if strucTyp, _ := util.GetTypeStruct(model.Typ); strucTyp != nil {
+		for i := range strucTyp.NumFields() {
+			if strucTyp.Field(i).Name() == fieldName {
+				index = i
+				break
+			}
+		}
+	}
+	doc, comment := model.FieldComments(fieldName)
+	return map[string]any{
+		"name":     fieldName,
+		"type":     fieldType,
+		"typeName": util.TypeString(typ, outPkgPath),
+		"kind":     typeKind(typ),
+		"elem":     elem,
+		"key":      key,
+		"base":     util.TypeString(base, outPkgPath),
+		"refDeep":  refDeep,
+		"embedded": fieldType.Embedded,
+		"exported": token.IsExported(fieldName),
+		"path":     path,
+		"fullPath": fullPath,
+		"index":    index,
+		"doc":      doc,
+		"comment":  comment,
+	}
+}
+
+func typeKind(typ types.Type) string {
+	if _, ok := typ.(*types.TypeParam); ok {
+		return KindTypeParam
+	}
+	switch typ.Underlying().(type) {
+	case *types.Basic:
+		return KindBasic
+	case *types.Pointer:
+		return KindPointer
+	case *types.Slice:
+		return KindSlice
+	case *types.Array:
+		return KindArray
+	case *types.Map:
+		return KindMap
+	case *types.Chan:
+		return KindChan
+	case *types.Struct:
+		return KindStruct
+	case *types.Interface:
+		return KindInterface
+	case *types.Signature:
+		return KindFunc
+	}
+	return ""
+}
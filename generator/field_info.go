@@ -33,6 +33,13 @@ func FiledPathAndAccessCheckCondition(receiverVar string, isReceiverReference, r
 	return accessInfo.FieldPath, accessInfo.ShortVar, conditions
 }
 
+// FieldPathAndParentsCheckCondition returns the assignable field path and the nil check conditions of the parent field pointers.
+func FieldPathAndParentsCheckCondition(receiverVar string, fieldParts []FieldInfo, uniqueNames *unique.Names) (string, []string) {
+	parents, field := fieldParts[:len(fieldParts)-1], fieldParts[len(fieldParts)-1]
+	_, parentPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, false, false, parents, uniqueNames)
+	return parentPath + "." + field.Name, conditions
+}
+
 type FieldConditionalPartsAccessInfo struct {
 	FieldPath       string
 	ShortVar        string
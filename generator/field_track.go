@@ -0,0 +1,133 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const (
+	DefaultDirtyMethod      = "Dirty"
+	DefaultIsDirtyMethod    = "IsDirty"
+	DefaultResetDirtyMethod = "ResetDirty"
+)
+
+// GenerateFieldTrack generates the field constants prefixed by the type name and the Dirty, IsDirty, ResetDirty methods
+// that read the modified fields from the receiver map field trackField.
+// Returns the tracking info and the field constant names by the field paths.
+func (g *Generator) GenerateFieldTrack(
+	model *struc.Model, receiverVar, trackField string, access FieldAccess, export, nolint bool,
+) (*FieldTrack, map[string]string, error) {
+	fieldType, ok := model.FieldsType[trackField]
+	if !ok {
+		return nil, nil, fmt.Errorf("track field '%s' not found", trackField)
+	}
+	dirtyMap, _ := fieldType.Type.Underlying().(*types.Map)
+	if dirtyMap == nil {
+		return nil, nil, fmt.Errorf("track field '%s' must be a map, actual %s", trackField, fieldType.Type)
+	}
+	key, _ := dirtyMap.Key().Underlying().(*types.Basic)
+	if key == nil || key.Kind() != types.String {
+		return nil, nil, fmt.Errorf("track field '%s' must have a string key, actual %s", trackField, dirtyMap.Key())
+	}
+	var mark string
+	if value, _ := dirtyMap.Elem().Underlying().(*types.Basic); value != nil && value.Kind() == types.Bool {
+		mark = "true"
+	} else if value, _ := dirtyMap.Elem().Underlying().(*types.Struct); value != nil && value.NumFields() == 0 {
+		mark = "struct{}{}"
+	} else {
+		return nil, nil, fmt.Errorf("track field '%s' must have a bool or struct{} value, actual %s", trackField, dirtyMap.Elem())
+	}
+	mapType, err := g.repackedTypeString(fieldType.Type)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyType, err := g.repackedTypeString(dirtyMap.Key())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	constType := GetFieldType(model.TypeName(), export, false)
+	if err := g.AddType(constType, BaseConstType); err != nil {
+		return nil, nil, err
+	}
+	allConstants, err := makeFieldConsts(g, model, export, false, true, immutable.Set[string]{})
+	if err != nil {
+		return nil, nil, err
+	}
+	constants := slice.Filter(allConstants, func(c FieldConst) bool {
+		path := c.FieldPath()
+		return path != trackField && path != access.Lock
+	})
+	if err := checkDuplicates(constants, true); err != nil {
+		return nil, nil, err
+	}
+	constNames := make(map[string]string, len(constants))
+	for i := range constants {
+		// the type name prefix avoids collisions with constants of other tracked types and with local identifiers
+		constants[i].name = IdentName(model.TypeName()+IdentName(constants[i].name, true), export)
+	}
+	for _, c := range constants {
+		if err := g.addConst(c.name, Quoted(c.value), constType); err != nil {
+			return nil, nil, err
+		}
+		constNames[c.FieldPath()] = c.name
+	}
+	g.addConstDelim()
+
+	track := &FieldTrack{Field: trackField, MapType: mapType, KeyType: keyType, Mark: mark}
+
+	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar), unique.DistinctBySuffix("_"))
+	params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
+	typeParams, _, paramNames := params.IdentDeclNamess()
+	slice.ForEach(paramNames, uniqueNames.Add)
+	slice.ForEach(slice.Convert(constants, FieldConst.Name), uniqueNames.Add)
+	uniqueNames.Add(constType)
+
+	dirty := receiverVar + "." + trackField
+	receiver := "func (" + receiverVar + " *" + model.TypeName() + typeParams + ") "
+	receiverCheck := []string{receiverVar + " != nil"}
+
+	fields := uniqueNames.Get("fields")
+	f := uniqueNames.Get("f")
+	start, end := access.conditions(receiverCheck, receiverVar, false)
+	dirtyName := IdentName(DefaultDirtyMethod, export)
+	dirtyBody := receiver + dirtyName + "() []" + constType + " {" + NoLint(nolint) + "\n" + start +
+		"if len(" + dirty + ") == 0 {\nreturn nil\n}\n" +
+		fields + " := make([]" + constType + ", 0, len(" + dirty + "))\n" +
+		"for _, " + f + " := range [...]" + constType + "{" + strings.Join(slice.Convert(constants, FieldConst.Name), ", ") + "} {\n" +
+		"if _, ok := " + dirty + "[" + keyType + "(" + f + ")]; ok {\n" + fields + " = append(" + fields + ", " + f + ")\n}\n}\n" +
+		"return " + fields + "\n" + end + "return nil\n}\n"
+	if err := g.AddMethod(model.TypeName(), dirtyName, dirtyBody); err != nil {
+		return nil, nil, err
+	}
+
+	isDirtyName := IdentName(DefaultIsDirtyMethod, export)
+	isDirtyBody := receiver + isDirtyName + "(" + f + " " + constType + ") bool {" + NoLint(nolint) + "\n" + start +
+		"_, ok := " + dirty + "[" + keyType + "(" + f + ")]\nreturn ok\n" + end + "return false\n}\n"
+	if err := g.AddMethod(model.TypeName(), isDirtyName, isDirtyBody); err != nil {
+		return nil, nil, err
+	}
+
+	start, end = access.conditions(receiverCheck, receiverVar, true)
+	resetDirtyName := IdentName(DefaultResetDirtyMethod, export)
+	resetDirtyBody := receiver + resetDirtyName + "() {" + NoLint(nolint) + "\n" + start + dirty + " = nil\n" + end + "}\n"
+	if err := g.AddMethod(model.TypeName(), resetDirtyName, resetDirtyBody); err != nil {
+		return nil, nil, err
+	}
+	return track, constNames, nil
+}
+
+// Tracked returns the access of the field that is marked as dirty by setters by the constant constName.
+func (a FieldAccess) Tracked(constName string, fieldType types.Type) FieldAccess {
+	a.Const = constName
+	a.Comparable = types.Comparable(fieldType) && !types.IsInterface(fieldType)
+	return a
+}
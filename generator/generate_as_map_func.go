@@ -4,7 +4,6 @@ import (
 	"github.com/m4gshm/gollections/op"
 	"github.com/m4gshm/gollections/op/delay/replace"
 	"github.com/m4gshm/gollections/op/delay/string_/wrap"
-	"github.com/m4gshm/gollections/seq"
 	"github.com/m4gshm/gollections/slice/split"
 
 	"github.com/m4gshm/fieldr/model/struc"
@@ -16,7 +15,7 @@ func (g *Generator) GenerateAsMapFunc(
 	model *struc.Model, name, keyType string,
 	constants []FieldConst,
 	rewriter *CodeRewriter,
-	export, snake, returnRefs, noReceiver, nolint, hardcodeValues bool,
+	export, snake, returnRefs, noReceiver, nolint, hardcodeValues, nested bool,
 ) (string, string, string, error) {
 
 	pkgName, err := g.GetPackageNameOrAlias(model.Package().Name(), model.Package().Path())
@@ -26,15 +25,22 @@ func (g *Generator) GenerateAsMapFunc(
 
 	typeName := model.TypeName()
 	receiverVar := TypeReceiverVar(typeName)
-	receiverRef := op.IfElse(returnRefs, "&"+receiverVar, receiverVar)
+	funcName := renameFuncByConfig(IdentName("AsMap", export), name)
 
 	mapVar := "m"
+	var nestedMaps *nestedMaps
+	if nested {
+		nestedMaps = newNestedMaps(funcName, rewriter, nolint)
+	}
+	mapInits, err := g.generateMapInits(mapVar, receiverVar, returnRefs, rewriter, nestedMaps, constants, model)
+	if err != nil {
+		return "", "", "", err
+	}
 	internal := "if " + receiverVar + " == nil{\nreturn nil\n}\n" +
 		mapVar + " := map[" + keyType + "]any{}\n" +
-		generateMapInits(mapVar, receiverRef, rewriter, constants, model) +
+		mapInits +
 		"return " + mapVar
 
-	funcName := renameFuncByConfig(IdentName("AsMap", export), name)
 	typParams := model.Typ.TypeParams()
 	receiverType := GetTypeName(typeName, pkgName) + typeparams.New(typParams, g.Repack, g.OutPkgPath).Ident()
 	returnType := "map[" + keyType + "]any"
@@ -43,14 +49,33 @@ func (g *Generator) GenerateAsMapFunc(
 	return receiverType, op.IfElse(noReceiver, funcName, MethodName(typeName, funcName)), body, nil
 }
 
-func generateMapInits(mapVar, recVar string, rewriter *CodeRewriter, constants []FieldConst, model *struc.Model) string {
-	return seq.Convert(seq.Of(constants...), func(constant FieldConst) string {
-		var (
-			_, conditionPath, conditions         = FiledPathAndAccessCheckCondition(recVar, false, false, constant.fieldPath, unique.NewNamesWith(unique.PreInit(recVar)))
-			varsConditionStart, varsConditionEnd = split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
-			field                                = constant.fieldPath[len(constant.fieldPath)-1]
-			revr, _                              = rewriter.Transform(field.Name, field.Type.FullName(model.OutPkgPath), conditionPath)
-		)
-		return varsConditionStart + mapVar + "[" + constant.name + "]= " + revr + "\n" + varsConditionEnd
-	}).Reduce(op.Sum)
+func (g *Generator) generateMapInits(mapVar, receiverVar string, returnRefs bool, rewriter *CodeRewriter, nested *nestedMaps, constants []FieldConst, model *struc.Model) (string, error) {
+	inits := ""
+	for _, constant := range constants {
+		uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar))
+		if nested != nil {
+			var (
+				fieldPath, conditions                = FieldPathAndParentsCheckCondition(receiverVar, constant.fieldPath, uniqueNames)
+				varsConditionStart, varsConditionEnd = split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+			)
+			init, err := g.nestedMapInit(nested, model, constant.fieldPath, mapVar, constant.name, fieldPath, returnRefs)
+			if err != nil {
+				return "", err
+			}
+			inits += varsConditionStart + init + varsConditionEnd
+			continue
+		}
+		// the rewriters are type checked against the field type, so the field reference is used only if the value is not rewritten
+		_, conditionPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, false, false, constant.fieldPath, uniqueNames)
+		value, rewritten, err := rewriter.Transform(g, model, constant.fieldPath, conditionPath)
+		if err != nil {
+			return "", err
+		} else if returnRefs && !rewritten {
+			uniqueNames = unique.NewNamesWith(unique.PreInit("&" + receiverVar))
+			_, value, conditions = FiledPathAndAccessCheckCondition("&"+receiverVar, false, false, constant.fieldPath, uniqueNames)
+		}
+		varsConditionStart, varsConditionEnd := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+		inits += varsConditionStart + mapVar + "[" + constant.name + "]= " + value + "\n" + varsConditionEnd
+	}
+	return inits, nil
 }
@@ -0,0 +1,174 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/op/delay/replace"
+	"github.com/m4gshm/gollections/op/delay/string_/wrap"
+	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/split"
+
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const (
+	DefaultSetAccessor     = "SetVal"
+	DefaultTypedAccessor   = "ValAs"
+	DefaultForEachAccessor = "ForEachField"
+)
+
+// constAccessor is the receiver and the signature parts of a function or method that accesses fields by constants.
+type constAccessor struct {
+	recVar, recType, typeParams, typeParamsDecl string
+	typeParamNames                              []string
+	isFunc                                      bool
+}
+
+func (g *Generator) newConstAccessor(model *struc.Model, pkgName string) constAccessor {
+	typeParams, typeParamsDecl, typeParamNames := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath).IdentDeclNamess()
+	return constAccessor{
+		recVar:         "s",
+		recType:        GetTypeName(model.TypeName(), pkgName),
+		typeParams:     typeParams,
+		typeParamsDecl: typeParamsDecl,
+		typeParamNames: typeParamNames,
+		isFunc:         len(pkgName) > 0,
+	}
+}
+
+func (a constAccessor) uniqueNames(names ...string) *unique.Names {
+	return unique.NewNamesWith(unique.PreInit(append(append([]string{a.recVar}, a.typeParamNames...), names...)...))
+}
+
+// head returns the method or function declaration head with the arguments.
+func (a constAccessor) head(name, args string) string {
+	if a.isFunc {
+		return "func " + name + a.typeParamsDecl + "(" + a.recVar + " *" + a.recType + a.typeParams + ", " + args + ") "
+	}
+	return "func (" + a.recVar + " *" + a.recType + a.typeParams + ") " + name + "(" + args + ") "
+}
+
+func (a constAccessor) funcName(name string) string {
+	return op.IfElse(a.isFunc, name, MethodName(a.recType, name))
+}
+
+// generateConstSetMethod generates a method or function that sets a value of the struct field by the constant.
+// Returns an error if the value type differs from the field type or a pointer of the field path is nil.
+func (g *Generator) generateConstSetMethod(model *struc.Model, pkgName, typ, name string, constants []FieldConst, nolint bool) (string, string, error) {
+	fmtPkg, err := g.AddImport("fmt", "", nil)
+	if err != nil {
+		return "", "", err
+	}
+	fmtPkg = op.IfElse(len(fmtPkg) > 0, fmtPkg, "fmt")
+	a := g.newConstAccessor(model, pkgName)
+	uniqueNames := a.uniqueNames(fmtPkg)
+	argVar, valueVar := uniqueNames.Get("f"), uniqueNames.Get("v")
+	typedVar := uniqueNames.Get("val")
+
+	body := a.head(name, argVar+" "+typ+", "+valueVar+" any") + "error {" + NoLint(nolint) + "\n" +
+		"if " + a.recVar + " == nil {\nreturn " + fmtPkg + ".Errorf(\"set %v: nil " + a.recType + "\", " + argVar + ")\n}\n" +
+		"switch " + argVar + " {\n"
+	for _, constant := range constants {
+		fieldType, err := g.repackedTypeString(constant.fieldPath[len(constant.fieldPath)-1].Type.Type)
+		if err != nil {
+			return "", "", err
+		}
+		path, conditions := FieldPathAndParentsCheckCondition(a.recVar, constant.fieldPath, a.uniqueNames(argVar, valueVar, typedVar, fmtPkg))
+		start, end := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+		body += "case " + constant.name + ":\n" +
+			typedVar + ", ok := " + valueVar + ".(" + fieldType + ")\n" +
+			"if !ok {\nreturn " + fmtPkg + ".Errorf(\"set %v: unexpected value type %T, expected " + strings.ReplaceAll(fieldType, "\"", "\\\"") + "\", " + argVar + ", " + valueVar + ")\n}\n" +
+			start + path + " = " + typedVar + "\nreturn nil\n" + end +
+			op.IfElse(len(conditions) > 0, "return "+fmtPkg+".Errorf(\"set %v: nil field path\", "+argVar+")\n", "")
+	}
+	body += "}\nreturn " + fmtPkg + ".Errorf(\"set %v: unknown field\", " + argVar + ")\n}\n"
+	return body, a.funcName(name), nil
+}
+
+// generateConstForEachMethod generates a method or function that calls the consumer with each constant and the field value
+// until the consumer returns false. The value is nil if a pointer of the field path is nil.
+func (g *Generator) generateConstForEachMethod(model *struc.Model, pkgName, typ, name string, constants []FieldConst, nolint bool) (string, string, error) {
+	a := g.newConstAccessor(model, pkgName)
+	uniqueNames := a.uniqueNames()
+	consumer, valueVar := uniqueNames.Get("f"), uniqueNames.Get("v")
+
+	body := a.head(name, consumer+" func("+typ+", any) bool") + "{" + NoLint(nolint) + "\n" +
+		"if " + a.recVar + " == nil {\nreturn\n}\n"
+	for _, constant := range constants {
+		path, conditions := FieldPathAndParentsCheckCondition(a.recVar, constant.fieldPath, a.uniqueNames(consumer, valueVar))
+		if len(conditions) == 0 {
+			body += "if !" + consumer + "(" + constant.name + ", " + path + ") {\nreturn\n}\n"
+		} else {
+			start, end := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+			body += "{\nvar " + valueVar + " any\n" + start + valueVar + " = " + path + "\n" + end +
+				"if !" + consumer + "(" + constant.name + ", " + valueVar + ") {\nreturn\n}\n}\n"
+		}
+	}
+	body += "}\n"
+	return body, a.funcName(name), nil
+}
+
+// generateConstTypedFuncs generates generic functions that get and set a field value of the type parameter by the constant.
+// The constants of fields with the same type share a type check of the type parameter, so the values are not boxed.
+// Returns the getter and the setter bodies.
+func (g *Generator) generateConstTypedFuncs(
+	model *struc.Model, pkgName, typ, getName, setName string, constants []FieldConst, nolint bool,
+) (string, string, error) {
+	a := g.newConstAccessor(model, pkgName)
+	uniqueNames := a.uniqueNames()
+	valType, argVar, valueVar, ptrVar := uniqueNames.Get("V"), uniqueNames.Get("f"), uniqueNames.Get("v"), uniqueNames.Get("p")
+	typeParamsDecl := "[" + valType + " any" + op.IfElse(len(a.typeParamsDecl) > 0, ", "+strings.Trim(a.typeParamsDecl, "[]"), "") + "]"
+	recArg := a.recVar + " *" + a.recType + a.typeParams
+
+	fieldTypes, groups := []string{}, map[string][]FieldConst{}
+	for _, constant := range constants {
+		fieldType, err := g.repackedTypeString(constant.fieldPath[len(constant.fieldPath)-1].Type.Type)
+		if err != nil {
+			return "", "", err
+		}
+		if _, ok := groups[fieldType]; !ok {
+			fieldTypes = append(fieldTypes, fieldType)
+		}
+		groups[fieldType] = append(groups[fieldType], constant)
+	}
+
+	getBody := "func " + getName + typeParamsDecl + "(" + recArg + ", " + argVar + " " + typ + ") (" + valueVar + " " + valType + ", ok bool) {" + NoLint(nolint) + "\n" +
+		"if " + a.recVar + " == nil {\nreturn " + valueVar + ", false\n}\n" +
+		"switch " + argVar + " {\n"
+	setBody := "func " + setName + typeParamsDecl + "(" + recArg + ", " + argVar + " " + typ + ", " + valueVar + " " + valType + ") bool {" + NoLint(nolint) + "\n" +
+		"if " + a.recVar + " == nil {\nreturn false\n}\n" +
+		"switch " + argVar + " {\n"
+	for _, fieldType := range fieldTypes {
+		group := groups[fieldType]
+		cases := "case " + strings.Join(slice.Convert(group, FieldConst.Name), ", ") + ":\n" +
+			ptrVar + ", ok := any(&" + valueVar + ").(*" + fieldType + ")\n"
+		getBody += cases + "if !ok {\nreturn " + valueVar + ", false\n}\n"
+		setBody += cases + "if !ok {\nreturn false\n}\n"
+		if len(group) > 1 {
+			getBody += "switch " + argVar + " {\n"
+			setBody += "switch " + argVar + " {\n"
+		}
+		for _, constant := range group {
+			path, conditions := FieldPathAndParentsCheckCondition(a.recVar, constant.fieldPath, a.uniqueNames(valType, argVar, valueVar, ptrVar))
+			start, end := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+			caseStart := op.IfElse(len(group) > 1, "case "+constant.name+":\n", "")
+			getBody += caseStart + start + "*" + ptrVar + " = " + path + "\nreturn " + valueVar + ", true\n" + end
+			setBody += caseStart + start + path + " = *" + ptrVar + "\nreturn true\n" + end
+		}
+		if len(group) > 1 {
+			getBody += "}\n"
+			setBody += "}\n"
+		}
+	}
+	getBody += "}\nreturn " + valueVar + ", false\n}\n"
+	setBody += "}\nreturn false\n}\n"
+	return getBody, setBody, nil
+}
+
+// typedSetterName returns the setter name of the typed getter.
+func typedSetterName(getName string, export bool) string {
+	return IdentName("Set", export) + camel(getName)
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/op/string_"
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/model/iface"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const DefaultDecoratorSuffix = "Decorator"
+
+// GenerateDecorator generates a struct that embeds the interface and calls the before and after hooks around every method.
+func (g *Generator) GenerateDecorator(model *iface.Model, name, before, after string, nolint bool) (Structure, error) {
+	typ := model.Typ()
+	ifaceType, err := g.accessibleTypeName(typ)
+	if err != nil {
+		return Structure{}, err
+	}
+	var (
+		typeParams, typeParamsDecl, typeParamNames = typeparams.New(typ.TypeParams(), g.Repack, g.OutPkgPath).IdentDeclNamess()
+
+		methods       = model.Methods()
+		methodNames   = immutable.NewSet(slice.Convert(methods, (*types.Func).Name)...)
+		embedded      = model.TypeName()
+		decoratorName = op.IfElse(name == Autoname, embedded+DefaultDecoratorSuffix, name)
+		decoratorType = decoratorName + typeParams
+		receiverVar   = "d"
+		decorator     = Structure{Name: decoratorName}
+	)
+	for _, field := range slice.Of(embedded, before, after) {
+		if methodNames.Contains(field) {
+			return Structure{}, fmt.Errorf("decorator field '%s' conflicts with the method of '%s'", field, embedded)
+		}
+	}
+	for _, method := range methods {
+		methodName := method.Name()
+		if pkg := method.Pkg(); !method.Exported() && pkg.Path() != g.OutPkgPath {
+			return Structure{}, fmt.Errorf("unexported method '%s' cannot be implemented outside the package %s", methodName, pkg.Path())
+		}
+		uniqueNames := unique.NewNamesWith(unique.PreInit(append(slice.Of(receiverVar, decoratorName), typeParamNames...)...))
+		params, err := g.GetMethodParams(method, uniqueNames)
+		if err != nil {
+			return Structure{}, err
+		}
+		results := slice.OfIndexed(len(params.Results), func(i int) string { return uniqueNames.Get("r" + strconv.Itoa(i)) })
+		var (
+			methodNameLit = strconv.Quote(methodName)
+			hookArgs      = func(vars []string) string { return string_.WrapNonEmpty(", ", strings.Join(vars, ", "), "") }
+			call          = receiverVar + "." + embedded + "." + methodName + "(" + params.Args() + ")\n"
+			content       = "if " + receiverVar + "." + before + " != nil {\n\t" + receiverVar + "." + before + "(" + methodNameLit + hookArgs(params.Names) + ")\n}\n"
+		)
+		if len(results) > 0 {
+			content += strings.Join(results, ", ") + " := " + call
+		} else {
+			content += call
+		}
+		content += "if " + receiverVar + "." + after + " != nil {\n\t" + receiverVar + "." + after + "(" + methodNameLit + hookArgs(results) + ")\n}\n"
+		if len(results) > 0 {
+			content += "return " + strings.Join(results, ", ") + "\n"
+		}
+		methodBody := "func (" + receiverVar + " " + decoratorType + ") " + methodName + params.Decl() + " " + params.ResultsDecl() + " {" + NoLint(nolint) + "\n" + content + "}\n"
+		if err := decorator.AddMethod(methodName, methodBody); err != nil {
+			return Structure{}, err
+		}
+	}
+	decorator.Body = decoratorName + typeParamsDecl + " struct {" + NoLint(nolint) + "\n" +
+		ifaceType + typeParams + "\n" +
+		before + " func(method string, args ...any)\n" +
+		after + " func(method string, results ...any)\n" +
+		"}\n"
+	return decorator, nil
+}
@@ -0,0 +1,153 @@
+package generator
+
+import (
+	"fmt"
+	"go/ast"
+	goconstant "go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"sort"
+
+	"github.com/m4gshm/fieldr/model/struc"
+)
+
+// DefaultValueExpr type-checks the default value expression of the field in the scope of the struct type declaration
+// and returns the expression with package qualifiers of the output file.
+func (g *Generator) DefaultValueExpr(model *struc.Model, fieldName struc.FieldName, fieldType types.Type, expr string) (string, error) {
+	return g.scopedExpr(model, "default value", fieldName, expr, func(tv types.TypeAndValue) error {
+		if !types.AssignableTo(tv.Type, fieldType) {
+			return fmt.Errorf("default value '%s' of type %s is not assignable to field '%s' of type %s",
+				expr, tv.Type, fieldName, fieldType)
+		} else if basic, ok := fieldType.Underlying().(*types.Basic); ok && tv.Value != nil && !representable(tv.Value, basic) {
+			return fmt.Errorf("default value '%s' cannot be represented by the type %s of field '%s'", expr, fieldType, fieldName)
+		}
+		return nil
+	})
+}
+
+// ValidatorFuncExpr type-checks the validation function of the field in the scope of the struct type declaration,
+// the function must be of type func(<field type>) error.
+func (g *Generator) ValidatorFuncExpr(model *struc.Model, fieldName struc.FieldName, fieldType types.Type, expr string) (string, error) {
+	return g.scopedExpr(model, "validation function", fieldName, expr, func(tv types.TypeAndValue) error {
+		sig, _ := tv.Type.Underlying().(*types.Signature)
+		if !tv.IsValue() || sig == nil || sig.TypeParams().Len() > 0 || sig.Variadic() || sig.Params().Len() != 1 || sig.Results().Len() != 1 ||
+			!types.Identical(sig.Results().At(0).Type(), types.Universe.Lookup("error").Type()) ||
+			!hasTypeParams(fieldType) && !types.AssignableTo(fieldType, sig.Params().At(0).Type()) {
+			return fmt.Errorf("validation function '%s' of type %s must be of type func(%s) error, field '%s'", expr, tv.Type, fieldType, fieldName)
+		}
+		return nil
+	})
+}
+
+// scopedExpr type-checks the expression in the scope of the struct type declaration
+// and returns the expression with package qualifiers of the output file.
+func (g *Generator) scopedExpr(model *struc.Model, kind string, fieldName struc.FieldName, expr string, check func(types.TypeAndValue) error) (string, error) {
+	exprFileSet := token.NewFileSet()
+	parsed, err := parser.ParseExprFrom(exprFileSet, "", expr, 0)
+	if err != nil {
+		return "", fmt.Errorf("parse %s '%s' of field '%s': %w", kind, expr, fieldName, err)
+	}
+	pkg := model.Package()
+	info := &types.Info{Types: map[ast.Expr]types.TypeAndValue{}, Uses: map[*ast.Ident]types.Object{}}
+	if err := types.CheckExpr(g.fileSet, pkg, model.Typ.Obj().Pos(), parsed, info); err != nil {
+		return "", fmt.Errorf("check %s '%s' of field '%s': %w", kind, expr, fieldName, err)
+	} else if err := check(info.Types[parsed]); err != nil {
+		return "", err
+	}
+
+	type replacement struct {
+		start, end int
+		text       string
+	}
+	base := exprFileSet.File(parsed.Pos()).Base()
+	offset := func(pos token.Pos) int { return int(pos) - base }
+	replacements := []replacement{}
+	qualified := map[*ast.Ident]bool{}
+	ast.Inspect(parsed, func(node ast.Node) bool {
+		if err != nil {
+			return false
+		}
+		switch n := node.(type) {
+		case *ast.SelectorExpr:
+			if ident, ok := n.X.(*ast.Ident); ok {
+				if pkgName, ok := info.Uses[ident].(*types.PkgName); ok {
+					qualified[n.Sel] = true
+					imported := pkgName.Imported()
+					var alias string
+					if alias, err = g.GetPackageNameOrAlias(imported.Name(), imported.Path()); err != nil {
+						return false
+					} else if len(alias) == 0 {
+						replacements = append(replacements, replacement{offset(ident.Pos()), offset(n.Sel.Pos()), ""})
+					} else if alias != ident.Name {
+						replacements = append(replacements, replacement{offset(ident.Pos()), offset(ident.End()), alias})
+					}
+				}
+			}
+		case *ast.Ident:
+			obj := info.Uses[n]
+			if qualified[n] || obj == nil || obj.Pkg() != pkg || obj.Parent() != pkg.Scope() {
+				return true
+			} else if pkg.Path() != g.OutPkgPath {
+				if !obj.Exported() {
+					err = fmt.Errorf("%s '%s' of field '%s' refers to unexported '%s' of the package %s", kind, expr, fieldName, n.Name, pkg.Path())
+					return false
+				}
+				var alias string
+				if alias, err = g.GetPackageNameOrAlias(pkg.Name(), pkg.Path()); err != nil {
+					return false
+				}
+				replacements = append(replacements, replacement{offset(n.Pos()), offset(n.Pos()), alias + "."})
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(replacements, func(i, j int) bool { return replacements[i].start > replacements[j].start })
+	for _, r := range replacements {
+		expr = expr[:r.start] + r.text + expr[r.end:]
+	}
+	return expr, nil
+}
+
+// representable checks whether the constant value fits the basic type without truncation or overflow.
+func representable(value goconstant.Value, typ *types.Basic) bool {
+	switch info := typ.Info(); {
+	case info&types.IsInteger != 0:
+		v := goconstant.ToInt(value)
+		if v.Kind() != goconstant.Int {
+			return false
+		}
+		var bits uint = 64
+		switch typ.Kind() {
+		case types.Int8, types.Uint8:
+			bits = 8
+		case types.Int16, types.Uint16:
+			bits = 16
+		case types.Int32, types.Uint32:
+			bits = 32
+		}
+		if info&types.IsUnsigned != 0 {
+			return goconstant.Sign(v) >= 0 && uint(goconstant.BitLen(v)) <= bits
+		}
+		minVal := goconstant.Shift(goconstant.MakeInt64(-1), token.SHL, bits-1)
+		maxVal := goconstant.Shift(goconstant.MakeInt64(1), token.SHL, bits-1)
+		return goconstant.Compare(v, token.GEQ, minVal) && goconstant.Compare(v, token.LSS, maxVal)
+	case info&types.IsFloat != 0:
+		v := goconstant.ToFloat(value)
+		if v.Kind() == goconstant.Unknown {
+			return false
+		} else if typ.Kind() == types.Float32 {
+			f, _ := goconstant.Float32Val(v)
+			return !math.IsInf(float64(f), 0)
+		}
+		f, _ := goconstant.Float64Val(v)
+		return !math.IsInf(f, 0)
+	case info&types.IsComplex != 0:
+		return goconstant.ToComplex(value).Kind() != goconstant.Unknown
+	}
+	return true
+}
@@ -0,0 +1,24 @@
+package generator
+
+import (
+	goconstant "go/constant"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_representable(t *testing.T) {
+	basic := types.Typ
+	assert.True(t, representable(goconstant.MakeInt64(255), basic[types.Uint8]))
+	assert.False(t, representable(goconstant.MakeInt64(300), basic[types.Uint8]))
+	assert.False(t, representable(goconstant.MakeInt64(-1), basic[types.Uint]))
+	assert.True(t, representable(goconstant.MakeInt64(-128), basic[types.Int8]))
+	assert.False(t, representable(goconstant.MakeInt64(128), basic[types.Int8]))
+	assert.False(t, representable(goconstant.MakeFloat64(1.5), basic[types.Int]))
+	assert.True(t, representable(goconstant.MakeFloat64(2.0), basic[types.Int]))
+	assert.True(t, representable(goconstant.MakeInt64(1), basic[types.Float32]))
+	assert.False(t, representable(goconstant.MakeFromLiteral("1e100", token.FLOAT, 0), basic[types.Float32]))
+	assert.True(t, representable(goconstant.MakeString("a"), basic[types.String]))
+}
@@ -105,7 +105,7 @@ func enumFromNameSwitchExpr[C c.Range[[]string]](consts C, receiverVar, resultVa
 }
 
 func (g *Generator) GenerateEnumName(typ util.TypeNamedOrAlias, constValNamesMap c.KVRange[goconstant.Value, []string],
-	name string, export bool, nolint bool) (string, string, error) {
+	name string, onlyCanonical, export, nolint bool) (string, string, error) {
 
 	obj := typ.Obj()
 	pkg := obj.Pkg()
@@ -119,7 +119,7 @@ func (g *Generator) GenerateEnumName(typ util.TypeNamedOrAlias, constValNamesMap
 	typParams := typ.TypeParams()
 
 	var (
-		returnSlice     = seq.Convert(seq2.Values(constValNamesMap.All), slice.Len).Reduce(op.Max) > 1
+		returnSlice     = !onlyCanonical && seq.Convert(seq2.Values(constValNamesMap.All), slice.Len).Reduce(op.Max) > 1
 		returnType      = op.IfElse(returnSlice, "[]string", "string")
 		receiverType    = GetTypeName(typeName, pkgName) + typeparams.New(typParams, g.Repack, g.OutPkgPath).Ident()
 		receiverVar     = TypeReceiverVar(typeName)
@@ -148,6 +148,45 @@ func constsSwitchExpr[C c.KVRange[goconstant.Value, []string]](consts C, receive
 	return expr + "default:\n\treturn " + op.IfElse(onlyFirst, "\"\"", "nil") + "\n}"
 }
 
+func (g *Generator) GenerateEnumAliases(typ util.TypeNamedOrAlias, constValNamesMap c.KVRange[goconstant.Value, []string],
+	name string, export bool, nolint bool) (string, string, error) {
+
+	obj := typ.Obj()
+	pkg := obj.Pkg()
+
+	pkgName, err := g.GetPackageNameOrAlias(pkg.Name(), pkg.Path())
+	if err != nil {
+		return "", "", err
+	}
+
+	typeName := obj.Name()
+	typParams := typ.TypeParams()
+
+	var (
+		receiverType    = GetTypeName(typeName, pkgName) + typeparams.New(typParams, g.Repack, g.OutPkgPath).Ident()
+		receiverVar     = TypeReceiverVar(typeName)
+		internalContent = aliasesSwitchExpr(constValNamesMap, receiverVar)
+		funcName        = IdentName(name, export)
+		body            = MethodBody(funcName, false, receiverVar, receiverType, "[]string", nolint, internalContent)
+	)
+	return MethodName(typeName, funcName), body, nil
+}
+
+func aliasesSwitchExpr[C c.KVRange[goconstant.Value, []string]](consts C, receiverVar string) string {
+	expr := "switch " + receiverVar + " {\n"
+	for _, names := range consts.All {
+		if len(names) < 2 {
+			continue
+		}
+		expr += "case " + names[0] + ":\n" + "\treturn []string{"
+		for i, name := range names[1:] {
+			expr += op.IfElse(i > 0, ",", "") + "\"" + name + "\""
+		}
+		expr += "}\n"
+	}
+	return expr + "default:\n\treturn nil\n}"
+}
+
 func (g *Generator) GenerateEnumValues(typ util.TypeNamedOrAlias, constValNamesMap c.KVRange[goconstant.Value, []string],
 	name string, export bool, nolint bool) (string, string, error) {
 
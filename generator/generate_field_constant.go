@@ -3,12 +3,18 @@ package generator
 import (
 	"fmt"
 	"go/token"
+	"maps"
 	"reflect"
 	"regexp"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
 	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/conf"
 	"github.com/expr-lang/expr/patcher/value"
 	"github.com/m4gshm/gollections/c"
 	"github.com/m4gshm/gollections/collection/immutable"
@@ -21,6 +27,7 @@ import (
 	"github.com/m4gshm/gollections/op/delay/string_/wrap"
 	"github.com/m4gshm/gollections/op/delay/sum"
 	"github.com/m4gshm/gollections/seq"
+	"github.com/m4gshm/gollections/slice"
 	"github.com/m4gshm/gollections/slice/convert"
 	"github.com/m4gshm/gollections/slice/split"
 	"github.com/pkg/errors"
@@ -31,12 +38,30 @@ import (
 	"github.com/m4gshm/fieldr/unique"
 )
 
-type stringer struct {
+// tagValue is the field tag value of expressions, it is used as the raw string or as the parsed tag options.
+type tagValue struct {
+	Name    string              `expr:"name"`
+	Options []string            `expr:"options"`
+	Has     func(string) bool   `expr:"has"`
+	Value   func(string) string `expr:"value"`
+
 	val      string
 	callback func()
 }
 
-func (c *stringer) String() string {
+func newTagValue(val string, callback func()) *tagValue {
+	options := struc.ParseTagOptions(val)
+	return &tagValue{
+		Name:     options.Name,
+		Options:  options.Options,
+		Has:      options.Has,
+		Value:    func(key string) string { v, _ := options.Value(key); return v },
+		val:      val,
+		callback: callback,
+	}
+}
+
+func (c *tagValue) String() string {
 	if c.isNil() {
 		return ""
 	}
@@ -44,21 +69,74 @@ func (c *stringer) String() string {
 	return c.val
 }
 
-func (c *stringer) isNil() bool {
+func (c *tagValue) isNil() bool {
 	return c == nil
 }
 
-func (c *stringer) AsString() string {
+func (c *tagValue) AsString() string {
 	return c.String()
 }
 
-var _ fmt.Stringer = (*stringer)(nil)
-var _ value.StringValuer = (*stringer)(nil)
+var _ fmt.Stringer = (*tagValue)(nil)
+var _ value.StringValuer = (*tagValue)(nil)
 
-func (g *Generator) GenerateFieldConstants(model *struc.Model, typ string, export, snake, allFields bool, flats c.Checkable[string]) ([]FieldConst, error) {
+const tagValueFunc = "$tag_value"
+
+// tagValueGetter converts tag values to strings in expressions except for access to the parsed tag options like tag.json.name.
+func tagValueGetter(tags map[string]*tagValue) expr.Option {
+	accessed := map[ast.Node]bool{}
+	return func(c *conf.Config) {
+		expr.Patch(tagAccessMarker{accessed: accessed, tags: tags})(c)
+		expr.Patch(tagValuePatcher{accessed: accessed})(c)
+		expr.Function(tagValueFunc, func(params ...any) (any, error) {
+			if tag, ok := params[0].(*tagValue); ok {
+				return tag.AsString(), nil
+			}
+			return params[0], nil
+		}, new(func(*tagValue) string))(c)
+	}
+}
+
+// tagAccessMarker marks the tag value nodes whose parsed options are accessed and registers the tag usage.
+type tagAccessMarker struct {
+	accessed map[ast.Node]bool
+	tags     map[string]*tagValue
+}
+
+func (m tagAccessMarker) Visit(node *ast.Node) {
+	if member, ok := (*node).(*ast.MemberNode); ok {
+		m.accessed[member.Node] = true
+		if tagMember, ok := member.Node.(*ast.MemberNode); ok {
+			ident, isIdent := tagMember.Node.(*ast.IdentifierNode)
+			property, isString := tagMember.Property.(*ast.StringNode)
+			if isIdent && ident.Value == "tag" && isString {
+				if tag := m.tags[property.Value]; tag != nil {
+					tag.callback()
+				}
+			}
+		}
+	}
+}
+
+type tagValuePatcher struct {
+	accessed map[ast.Node]bool
+}
+
+func (p tagValuePatcher) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IdentifierNode, *ast.MemberNode:
+		if !p.accessed[n] && n.Type() == reflect.TypeOf((*tagValue)(nil)) {
+			ast.Patch(node, &ast.CallNode{Callee: &ast.IdentifierNode{Value: tagValueFunc}, Arguments: []ast.Node{n}})
+		}
+	}
+}
+
+func (g *Generator) GenerateFieldConstants(model *struc.Model, typ string, export, snake, allFields bool, flats c.Checkable[string], sortExpr string) ([]FieldConst, error) {
 	constants, err := makeFieldConsts(g, model, export, snake, allFields, flats)
 	if err != nil {
 		return nil, err
+	} else if err := g.SortFieldConstants(model, constants, sortExpr, FlatPaths{}); err != nil {
+		return nil, err
 	} else if err := checkDuplicates(constants, true); err != nil {
 		return nil, err
 	}
@@ -76,12 +154,19 @@ func (g *Generator) GenerateFieldConstants(model *struc.Model, typ string, expor
 }
 
 func (g *Generator) GenerateFieldConstant(
-	model *struc.Model, valueTmpl, nameTmpl, typ, funcList, typeMethod, refAccessor, valAccessor string,
-	export, snake, nolint, compact, usePrivate, notDeclateConsType, uniqueValues bool,
-	flats, excludedFields c.Checkable[string], include string,
+	model *struc.Model, valueTmpl, nameTmpl, typ, funcList, typeMethod, refAccessor, valAccessor, setAccessor, typedAccessor, forEachAccessor string,
+	export, snake, nolint, compact, usePrivate, notDeclateConsType, uniqueValues, vars bool,
+	flats, excludedFields c.Checkable[string], include, sortExpr string, flatPaths FlatPaths,
 ) error {
 	// valueTmpl, nameTmpl, include = wrapTemplate(valueTmpl), wrapTemplate(nameTmpl), wrapTemplate(include)
 
+	if vars {
+		if len(typeMethod) > 0 || len(refAccessor) > 0 || len(valAccessor) > 0 || len(setAccessor) > 0 || len(typedAccessor) > 0 || len(forEachAccessor) > 0 {
+			return fmt.Errorf("field name and value accessors are unsupported for variables")
+		}
+		return g.generateFieldVars(model, valueTmpl, nameTmpl, typ, funcList, export, snake, nolint, compact, usePrivate, uniqueValues, flats, excludedFields, include, sortExpr, flatPaths)
+	}
+
 	wrapType := len(typ) > 0
 	if !wrapType {
 		typ = BaseConstType
@@ -93,9 +178,11 @@ func (g *Generator) GenerateFieldConstant(
 
 	logger.Debugf("GenerateFieldConstant wrapType %v, typ %v, nameTmpl %v valueTmpl %v\n", wrapType, typ, nameTmpl, valueTmpl)
 
-	constants, err := makeFieldConstsTempl(g, model, model.TypeName(), nameTmpl, valueTmpl, export, snake, usePrivate, flats, excludedFields, include)
+	constants, err := makeFieldConstsTempl(g, model, model, "", nameTmpl, valueTmpl, export, snake, usePrivate, flats, excludedFields, include, flatPaths, struc.HandledStructs{model.Typ: model}, 0)
 	if err != nil {
 		return err
+	} else if err = g.SortFieldConstants(model, constants, sortExpr, flatPaths); err != nil {
+		return err
 	} else if err = checkDuplicates(constants, uniqueValues); err != nil {
 		return err
 	}
@@ -124,6 +211,11 @@ func (g *Generator) GenerateFieldConstant(
 	}
 
 	if wrapType {
+		// constants with equal values are the same switch case of accessors
+		accessors := len(refAccessor) > 0 || len(valAccessor) > 0 || len(setAccessor) > 0 || len(typedAccessor) > 0 || len(forEachAccessor) > 0
+		if constants, err = distinctValues(constants); err != nil && (len(typeMethod) > 0 || accessors) {
+			return err
+		}
 		if len(typeMethod) > 0 {
 			funcName := op.IfElse(typeMethod == Autoname, IdentName("Field", export), typeMethod)
 			if funcBody, err := g.generateConstFieldMethod(typ, funcName, constants, exportFunc, nolint); err != nil {
@@ -136,7 +228,7 @@ func (g *Generator) GenerateFieldConstant(
 
 		logger.Debugf("valAccessor %s, refAccessor %s", valAccessor, refAccessor)
 
-		if len(refAccessor) != 0 || len(valAccessor) != 0 {
+		if accessors {
 			pkgName, err := g.GetPackageNameOrAlias(model.Package().Name(), model.Package().Path())
 			if err != nil {
 				return err
@@ -159,12 +251,80 @@ func (g *Generator) GenerateFieldConstant(
 					return err
 				}
 			}
+			if len(setAccessor) != 0 {
+				funcName := op.IfElse(setAccessor == Autoname, IdentName(DefaultSetAccessor, export), setAccessor)
+				if funcBody, funcName, err := g.generateConstSetMethod(model, pkgName, typ, funcName, constants, nolint); err != nil {
+					return err
+				} else if err := g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+			}
+			if len(forEachAccessor) != 0 {
+				funcName := op.IfElse(forEachAccessor == Autoname, IdentName(DefaultForEachAccessor, export), forEachAccessor)
+				if funcBody, funcName, err := g.generateConstForEachMethod(model, pkgName, typ, funcName, constants, nolint); err != nil {
+					return err
+				} else if err := g.AddFuncOrMethod(funcName, funcBody); err != nil {
+					return err
+				}
+			}
+			if len(typedAccessor) != 0 {
+				getName := op.IfElse(typedAccessor == Autoname, IdentName(DefaultTypedAccessor, export), typedAccessor)
+				setName := typedSetterName(getName, export)
+				if getBody, setBody, err := g.generateConstTypedFuncs(model, pkgName, typ, getName, setName, constants, nolint); err != nil {
+					return err
+				} else if err := g.AddFuncOrMethod(getName, getBody); err != nil {
+					return err
+				} else if err := g.AddFuncOrMethod(setName, setBody); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
 	return nil
 }
 
+// FlatPaths configures the recursive generation of constants for fields of nested structs.
+type FlatPaths struct {
+	// All enables the generation for fields of all nested non-embedded structs.
+	All bool
+	// MaxDepth limits the nesting level, 0 is unlimited.
+	MaxDepth int
+	// NameSep separates the field path parts in auto generated constant names.
+	NameSep string
+	// ValueSep separates the field path parts of the field.fullPath expression property, the default is '.'.
+	ValueSep string
+}
+
+// flatten checks whether the fields of the nested struct field are used instead of the field.
+// Recursive types and structs without accessible fields like time.Time are not flattened.
+func (f FlatPaths) flatten(fieldType struc.FieldType, outPkgPath string, visited struc.HandledStructs, depth int) bool {
+	fieldModel := fieldType.Model
+	if !f.All || fieldModel == nil || (f.MaxDepth > 0 && depth >= f.MaxDepth) {
+		return false
+	} else if _, ok := visited[fieldModel.Typ]; ok {
+		logger.Debugf("recursive type %s is not flattened", fieldModel.TypeName())
+		return false
+	}
+	return fieldModel.Package().Path() == outPkgPath || slices.ContainsFunc(fieldModel.FieldNames, token.IsExported)
+}
+
+func (f FlatPaths) join(path string, fieldName struc.FieldName, sep string, ident bool) string {
+	if len(path) == 0 {
+		return fieldName
+	}
+	parts := append(strings.Split(path, "."), fieldName)
+	if ident {
+		parts = slice.Convert(parts, camel)
+	}
+	return strings.Join(parts, sep)
+}
+
+// fullPath joins the dot separated path and the field name by the value separator, it is the field.fullPath expression property.
+func (f FlatPaths) fullPath(path string, fieldName struc.FieldName) string {
+	return f.join(path, fieldName, op.IfElse(len(f.ValueSep) > 0, f.ValueSep, "."), false)
+}
+
 type FieldConst struct {
 	name, value string
 	fieldPath   []FieldInfo
@@ -172,6 +332,26 @@ type FieldConst struct {
 
 func (constant FieldConst) Name() string { return constant.name }
 
+// FieldPath returns the dot separated names of the struct fields from the root type to the constant field.
+func (constant FieldConst) FieldPath() string {
+	return strings.Join(slice.Convert(constant.fieldPath, func(f FieldInfo) string { return f.Name }), ".")
+}
+
+// distinctValues returns the first constant of each value, constants of the same value must refer to the same field.
+func distinctValues(constants []FieldConst) ([]FieldConst, error) {
+	byValue := map[string]FieldConst{}
+	distinct := make([]FieldConst, 0, len(constants))
+	for _, c := range constants {
+		if first, ok := byValue[c.value]; !ok {
+			byValue[c.value] = c
+			distinct = append(distinct, c)
+		} else if first.FieldPath() != c.FieldPath() {
+			return nil, fmt.Errorf("constants '%s' and '%s' of different fields have the same value '%s'", first.name, c.name, c.value)
+		}
+	}
+	return distinct, nil
+}
+
 func checkDuplicates(constants []FieldConst, checkValues bool) error {
 	uniqueVals, uniqueNames := map[string]string{}, map[string]string{}
 	for _, c := range constants {
@@ -193,7 +373,8 @@ func checkDuplicates(constants []FieldConst, checkValues bool) error {
 }
 
 func makeFieldConstsTempl(
-	g *Generator, model *struc.Model, structType, nameTmpl, valueTmpl string, export, snake, usePrivate bool, flats, excludedFields c.Checkable[string], include string,
+	g *Generator, structModel, model *struc.Model, path, nameTmpl, valueTmpl string, export, snake, usePrivate bool, flats, excludedFields c.Checkable[string], include string,
+	flatPaths FlatPaths, visited struc.HandledStructs, depth int,
 ) ([]FieldConst, error) {
 	var (
 		usedTags  = &ordered.Set[struc.TagName]{}
@@ -212,14 +393,25 @@ func makeFieldConstsTempl(
 		if excludedFields.Contains(fieldName) {
 			logger.Debugf("optional exclude field %v\n", fieldName)
 			continue
+		} else if model.FieldOptions(fieldName).Skip {
+			logger.Debugf("skip field %v by tag %s\n", fieldName, struc.Tag)
+			continue
 		}
 
 		embedded := fieldType.Embedded
-		flat := flats.Contains(fieldName)
+		flatAll := !embedded && flatPaths.flatten(fieldType, g.OutPkgPath, visited, depth)
+		flat := flats.Contains(fieldName) || flatAll
 		fieldModel := fieldType.Model
 		if flat || embedded {
 			subflats := use.If(embedded, flats).Else(immutable.Set[string]{})
-			fieldConstants, err := makeFieldConstsTempl(g, fieldModel, structType, nameTmpl, valueTmpl, export, snake, usePrivate, subflats, excludedFields, include)
+			subvisited := visited
+			if fieldModel != nil {
+				subvisited = maps.Clone(visited)
+				subvisited[fieldModel.Typ] = fieldModel
+			}
+			fieldConstants, err := makeFieldConstsTempl(g, structModel, fieldModel, get.If(len(path) > 0, sum.Of(path, ".", fieldName)).Else(fieldName),
+				nameTmpl, valueTmpl, export, snake, usePrivate, subflats, excludedFields, include,
+				flatPaths, subvisited, op.IfElse(embedded, depth, depth+1))
 			if err != nil {
 				return nil, err
 			}
@@ -229,51 +421,55 @@ func makeFieldConstsTempl(
 			constants = append(constants, fieldConstants...)
 		} else {
 			var (
-				tags      = map[string]*stringer{}
+				tags      = map[string]*tagValue{}
 				inExecute bool
 			)
 			if tagVals := model.FieldsTagValue[fieldName]; tagVals != nil {
 				for t, v := range tagVals {
 					tag, val := t, v
-					tags[tag] = &stringer{val: val, callback: func() {
+					tags[tag] = newTagValue(val, func() {
 						if !inExecute {
 							return
 						}
 						if ok := usedTags.AddOneNew(tag); !ok {
 							logger.Debugf("use tag '%s'", tag)
 						}
-					}}
+					})
 				}
 			}
 
-			evalStringVal := func(name string, env map[string]any, tmplVal string) (string, error) {
+			evalVal := func(name string, env map[string]any, tmplVal string) (any, error) {
 				logger.Debugf("parse expression for \"%s\" %s\n", name, tmplVal)
 
 				if len(tmplVal) == 0 {
-					return "", nil
+					return nil, nil
 				}
 
 				inExecute = true
 				defer func() { inExecute = false }()
-				program, err := expr.Compile(tmplVal, expr.Env(env), value.ValueGetter)
+				program, err := expr.Compile(tmplVal, expr.Env(env), tagValueGetter(tags))
 				if err != nil {
-					return "", fmt.Errorf("compile: of '%s', expression %s: %w", name, tmplVal, err)
+					return nil, fmt.Errorf("compile: of '%s', expression %s: %w", name, tmplVal, err)
 				}
 
 				cmpVal, err := expr.Run(program, env)
 				if err != nil {
-					return "", fmt.Errorf("run: of '%s', expression %s: %w", name, tmplVal, err)
+					return nil, fmt.Errorf("run: of '%s', expression %s: %w", name, tmplVal, err)
 				}
 
 				logger.Debugf("parse result: of '%s'; %s\n", name, cmpVal)
-				val := toString(cmpVal)
-				return val, nil
+				return cmpVal, nil
+			}
+
+			evalStringVal := func(name string, env map[string]any, tmplVal string) (string, error) {
+				val, err := evalVal(name, env, tmplVal)
+				return toString(val), err
 			}
 
 			env := addCommonFuncs(map[string]any{
-				"struct": map[string]any{"name": structType},
+				"struct": structEnv(structModel, g.OutPkgPath),
 				"name":   fieldName,
-				"field":  map[string]any{"name": fieldName, "type": fieldType},
+				"field":  fieldEnv(model, g.OutPkgPath, path, flatPaths.fullPath(path, fieldName), fieldName, fieldType),
 				"tag":    tags,
 			})
 
@@ -288,30 +484,46 @@ func makeFieldConstsTempl(
 				}
 			}
 
-			val, err := evalStringVal(fieldName+" const val", env, valueTmpl)
+			result, err := evalVal(fieldName+" const val", env, valueTmpl)
 			if err != nil {
 				return nil, err
 			}
-
-			var constName string
-			if len(nameTmpl) > 0 {
-				parsedConst, err := evalStringVal(fieldName+" const name", env, nameTmpl)
-				if err != nil {
-					return nil, err
+			keys, vals, multi := constValues(result)
+
+			var names []string
+			for i, val := range vals {
+				var constName string
+				if len(nameTmpl) > 0 {
+					env["value"], env["index"], env["key"] = val, i, keys[i]
+					parsed, err := evalVal(fieldName+" const name", env, nameTmpl)
+					if err != nil {
+						return nil, err
+					}
+					if parsedNames, ok := multiValueNames(parsed, len(vals)); ok {
+						names = parsedNames
+					}
+					if names != nil {
+						constName = strings.ReplaceAll(names[i], ".", "")
+					} else {
+						constName = strings.ReplaceAll(toString(parsed), ".", "")
+					}
+				} else if multi && len(keys[i]) > 0 {
+					constName = LegalIdentName(convertFieldPathToGoIdent(keys[i]))
+				} else {
+					constName = g.getTagTemplateConstName(structModel.TypeName(),
+						op.IfElse(flatPaths.All, flatPaths.join(path, fieldName, flatPaths.NameSep, true), fieldName), usedTags.Slice(), export, snake) +
+						op.IfElse(multi, strconv.Itoa(i), "")
+					logger.Debugf("apply auto constant name '%s'", constName)
 				}
-				constName = strings.ReplaceAll(parsedConst, ".", "")
-			} else {
-				constName = g.getTagTemplateConstName(structType, fieldName, usedTags.Slice(), export, snake)
-				logger.Debugf("apply auto constant name '%s'", constName)
-			}
 
-			if len(val) > 0 {
-				constants = append(constants, FieldConst{
-					name:      constName,
-					value:     val,
-					fieldPath: []FieldInfo{{Name: fieldName, Type: fieldType}}})
-			} else {
-				logger.Infof("constant without value: '%s', value expression: '%s'", constName, valueTmpl)
+				if len(val) > 0 {
+					constants = append(constants, FieldConst{
+						name:      constName,
+						value:     val,
+						fieldPath: []FieldInfo{{Name: fieldName, Type: fieldType}}})
+				} else {
+					logger.Infof("constant without value: '%s', value expression: '%s'", constName, valueTmpl)
+				}
 			}
 		}
 	}
@@ -321,6 +533,9 @@ func makeFieldConstsTempl(
 func makeFieldConsts(g *Generator, model *struc.Model, export, snake, allFields bool, flats c.Checkable[string]) ([]FieldConst, error) {
 	constants := []FieldConst{}
 	for fieldName, fieldType := range model.FieldsNameAndType {
+		if model.FieldOptions(fieldName).Skip {
+			continue
+		}
 		embedded := fieldType.Embedded
 		flat := flats.Contains(fieldName)
 		filedInfo := FieldInfo{Name: fieldName, Type: fieldType}
@@ -361,6 +576,51 @@ func isNil(val any) bool {
 	}
 }
 
+// constValues returns the constant values of the value expression result, the result can be a single value, a list or a map.
+// Keys are the map keys sorted or empty strings for other results.
+func constValues(result any) (keys []string, values []string, multi bool) {
+	if isNil(result) {
+		return []string{""}, []string{""}, false
+	}
+	switch rv := reflect.ValueOf(result); rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		keys, values = make([]string, rv.Len()), make([]string, rv.Len())
+		for i := range rv.Len() {
+			values[i] = toString(rv.Index(i).Interface())
+		}
+		return keys, values, true
+	case reflect.Map:
+		byKey := map[string]string{}
+		for iter := rv.MapRange(); iter.Next(); {
+			key := toString(iter.Key().Interface())
+			keys = append(keys, key)
+			byKey[key] = toString(iter.Value().Interface())
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			values = append(values, byKey[key])
+		}
+		return keys, values, true
+	}
+	return []string{""}, []string{toString(result)}, false
+}
+
+// multiValueNames returns the constant names if the name expression result is a list of the values size.
+func multiValueNames(result any, size int) ([]string, bool) {
+	if isNil(result) {
+		return nil, false
+	}
+	rv := reflect.ValueOf(result)
+	if kind := rv.Kind(); (kind != reflect.Slice && kind != reflect.Array) || rv.Len() != size {
+		return nil, false
+	}
+	names := make([]string, size)
+	for i := range size {
+		names[i] = toString(rv.Index(i).Interface())
+	}
+	return names, true
+}
+
 func toString(val any) string {
 	if isNil(val) {
 		return ""
@@ -447,6 +707,10 @@ func addCommonFuncs[M ~map[string]any](funcs M) M {
 		return strings.ToLower(toString(val))
 	}
 
+	quote := func(val any) string {
+		return strconv.Quote(toString(val))
+	}
+
 	join := func(vals ...any) string {
 		result := strings.Join(toStrings(vals), "")
 		return result
@@ -477,6 +741,7 @@ func addCommonFuncs[M ~map[string]any](funcs M) M {
 		"toLower":     toLower,
 		"up":          toUpper,
 		"low":         toLower,
+		"quote":       quote,
 	}
 	for k, v := range f {
 		funcs[k] = v
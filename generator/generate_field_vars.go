@@ -0,0 +1,148 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/op"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"github.com/m4gshm/fieldr/logger"
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
+)
+
+// generateFieldVars generates package level variables, the value expression result is used as the Go expression of the variable value.
+func (g *Generator) generateFieldVars(
+	model *struc.Model, valueTmpl, nameTmpl, typ, funcList string,
+	export, snake, nolint, compact, usePrivate, uniqueValues bool,
+	flats, excludedFields c.Checkable[string], include, sortExpr string, flatPaths FlatPaths,
+) error {
+	logger.Debugf("generateFieldVars typ %v, nameTmpl %v valueTmpl %v\n", typ, nameTmpl, valueTmpl)
+
+	vars, err := makeFieldConstsTempl(g, model, model, "", nameTmpl, valueTmpl, export, snake, usePrivate, flats, excludedFields, include, flatPaths, struc.HandledStructs{model.Typ: model}, 0)
+	if err != nil {
+		return err
+	} else if err = g.SortFieldConstants(model, vars, sortExpr, flatPaths); err != nil {
+		return err
+	} else if err = checkDuplicates(vars, uniqueValues); err != nil {
+		return err
+	}
+	for _, v := range vars {
+		if value, err := g.importUsedPackages(model.Package(), model.Typ.Obj().Pos(), v.value); err != nil {
+			return fmt.Errorf("invalid value of variable %s: '%s': %w", v.name, v.value, err)
+		} else if err := g.AddVar(v.name, value, typ); err != nil {
+			return err
+		}
+	}
+	g.addVarDelim()
+
+	if len(funcList) > 0 {
+		if len(typ) == 0 {
+			return fmt.Errorf("list function is unsupported without variable type")
+		}
+		funcName := op.IfElse(funcList == Autoname, IdentName(typeBaseName(typ)+"s", export), funcList)
+		if funcBody, funcName, err := generateAggregateFunc(funcName, typ, vars, export, compact, nolint); err != nil {
+			return err
+		} else if err := g.AddFuncOrMethod(funcName, funcBody); err != nil {
+			return err
+		}
+		g.addFunсDelim()
+	}
+	return nil
+}
+
+// typeBaseName returns the type name without package and type arguments.
+func typeBaseName(typ string) string {
+	typ = strings.TrimLeft(typ, "*")
+	if i := strings.Index(typ, "["); i >= 0 {
+		typ = typ[:i]
+	}
+	if i := strings.LastIndex(typ, "."); i >= 0 {
+		typ = typ[i+1:]
+	}
+	return typ
+}
+
+// importUsedPackages imports the struct package and the packages referenced by the selectors of the expression.
+// The selectors are resolved by the imports of the struct file declared at the position,
+// the package qualifiers are renamed if the output file imports a package under another name.
+func (g *Generator) importUsedPackages(pkg *types.Package, pos token.Pos, expression string) (string, error) {
+	value, err := parser.ParseExpr(expression)
+	if err != nil || pkg == nil {
+		return expression, err
+	}
+	candidates := map[string]*types.Package{}
+	if scope := fileScope(pkg, pos); scope != nil {
+		for _, name := range scope.Names() {
+			if pkgName, ok := scope.Lookup(name).(*types.PkgName); ok {
+				candidates[name] = pkgName.Imported()
+			}
+		}
+	} else {
+		for _, imp := range pkg.Imports() {
+			candidates[imp.Name()] = imp
+		}
+	}
+	if pkg.Path() != g.OutPkgPath {
+		candidates[pkg.Name()] = pkg
+	}
+	requalified := false
+	astutil.Apply(value, func(cursor *astutil.Cursor) bool {
+		sel, ok := cursor.Node().(*ast.SelectorExpr)
+		if !ok || err != nil {
+			return err == nil
+		}
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		imported, ok := candidates[ident.Name]
+		if !ok {
+			return true
+		} else if imported.Path() == g.OutPkgPath {
+			cursor.Replace(sel.Sel)
+			requalified = true
+			return false
+		}
+		defaultName := util.GetPackageName(imported.Path())
+		alias, aerr := g.AddImport(imported.Path(), op.IfElse(ident.Name == defaultName, "", ident.Name), nil)
+		if err = aerr; err == nil {
+			if alias = op.IfElse(len(alias) > 0, alias, defaultName); alias != ident.Name {
+				ident.Name = alias
+				requalified = true
+			}
+		}
+		return false
+	}, nil)
+	if err != nil {
+		return "", err
+	} else if !requalified {
+		return expression, nil
+	}
+	out := &bytes.Buffer{}
+	if err := printer.Fprint(out, token.NewFileSet(), value); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// fileScope returns the scope of the package file that contains the position or nil if the package has no file scopes.
+func fileScope(pkg *types.Package, pos token.Pos) *types.Scope {
+	if !pos.IsValid() {
+		return nil
+	}
+	for scope := pkg.Scope().Innermost(pos); scope != nil; scope = scope.Parent() {
+		if scope.Parent() == pkg.Scope() {
+			return scope
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package generator
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/m4gshm/gollections/c"
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/op/delay/replace"
+	"github.com/m4gshm/gollections/op/delay/string_/wrap"
+	"github.com/m4gshm/gollections/slice"
+	"github.com/m4gshm/gollections/slice/split"
+
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const (
+	DefaultFieldsMetaName    = "FieldsMeta"
+	DefaultFieldMetaType     = "FieldMeta"
+	DefaultFieldMetaByName   = "FieldMetaByName"
+	DefaultFieldMetaByTag    = "FieldMetaByTag"
+	fieldsMetaReceiverVar    = "s"
+	fieldsMetaSetterValueVar = "v"
+)
+
+// FieldsMetaNames are the names of the generated field metadata type and functions.
+type FieldsMetaNames struct {
+	Type, List, ByName, ByTag string
+}
+
+// DefaultFieldsMetaNames returns the names of the field metadata type and functions based on the struct type name.
+func DefaultFieldsMetaNames(typeName string, export bool) FieldsMetaNames {
+	return FieldsMetaNames{
+		Type:   IdentName(typeName+DefaultFieldMetaType, export),
+		List:   IdentName(typeName+DefaultFieldsMetaName, export),
+		ByName: IdentName(typeName+DefaultFieldMetaByName, export),
+		ByTag:  IdentName(typeName+DefaultFieldMetaByTag, export),
+	}
+}
+
+// GenerateFieldsMeta generates the field constants, the field metadata type and the functions that return
+// the metadata of all fields, a field by the name and a field by the tag name value.
+// The metadata contains the field name, constant, tags, type string and the value getter and setter.
+func (g *Generator) GenerateFieldsMeta(
+	model *struc.Model, names FieldsMetaNames, export, allFields, nolint bool, flats c.Checkable[string],
+) error {
+	pkgName, err := g.GetPackageNameOrAlias(model.Package().Name(), model.Package().Path())
+	if err != nil {
+		return err
+	}
+	constType := GetFieldType(model.TypeName(), export, false)
+	if err := g.AddType(constType, BaseConstType); err != nil {
+		return err
+	}
+	constants, err := makeFieldConsts(g, model, export, false, allFields && len(pkgName) == 0, flats)
+	if err != nil {
+		return err
+	} else if err := checkDuplicates(constants, true); err != nil {
+		return err
+	}
+	for _, c := range constants {
+		if err := g.addConst(c.name, Quoted(c.value), constType); err != nil {
+			return err
+		}
+	}
+	g.addConstDelim()
+
+	typeParams, typeParamsDecl, _ := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath).IdentDeclNamess()
+	recType := "*" + GetTypeName(model.TypeName(), pkgName) + typeParams
+	metaType := names.Type + typeParams
+
+	if err := g.AddStruct(Structure{Name: names.Type, Body: names.Type + typeParamsDecl + " struct {" + NoLint(nolint) + "\n" +
+		"Name string\n" +
+		"Field " + constType + "\n" +
+		"Type string\n" +
+		"Tags map[string]string\n" +
+		"Get func(" + recType + ") any\n" +
+		"Set func(" + recType + ", any) bool\n" +
+		"}"}); err != nil {
+		return err
+	}
+
+	items := make([]string, len(constants))
+	byTag := map[string]map[string]int{}
+	for i, constant := range constants {
+		field := constant.fieldPath[len(constant.fieldPath)-1]
+		fieldType, err := g.repackedTypeString(field.Type.Type)
+		if err != nil {
+			return err
+		}
+		tags := fieldTags(model, constant.fieldPath)
+		for _, tag := range sortedKeys(tags) {
+			if name := struc.ParseTagOptions(tags[tag]).Name; len(name) > 0 && name != "-" {
+				if byTag[tag] == nil {
+					byTag[tag] = map[string]int{}
+				}
+				if _, ok := byTag[tag][name]; !ok {
+					byTag[tag][name] = i
+				}
+			}
+		}
+		items[i] = "{\n" +
+			"Name: " + Quoted(field.Name) + ",\n" +
+			"Field: " + constant.name + ",\n" +
+			"Type: " + Quoted(fieldType) + ",\n" +
+			"Tags: " + tagsMapLiteral(tags) + ",\n" +
+			"Get: " + fieldMetaGetter(recType, constant.fieldPath) + ",\n" +
+			"Set: " + fieldMetaSetter(recType, fieldType, constant.fieldPath) + ",\n" +
+			"}"
+	}
+
+	listBody := "func " + names.List + typeParamsDecl + "() []" + metaType + " {" + NoLint(nolint) + "\n" +
+		"return []" + metaType + "{\n" + strings.Join(slice.Convert(items, func(item string) string { return item + ",\n" }), "") + "}\n}\n"
+	if err := g.AddFuncOrMethod(names.List, listBody); err != nil {
+		return err
+	}
+
+	// the lookups build the found element only
+	byNameBody := "func " + names.ByName + typeParamsDecl + "(name string) (" + metaType + ", bool) {" + NoLint(nolint) + "\n" +
+		"switch name {\n"
+	byName := map[string]bool{}
+	for i, constant := range constants {
+		// the outer field shadows the embedded one
+		if name := constant.fieldPath[len(constant.fieldPath)-1].Name; !byName[name] {
+			byName[name] = true
+			byNameBody += "case " + Quoted(name) + ":\nreturn " + metaType + items[i] + ", true\n"
+		}
+	}
+	byNameBody += "}\nreturn " + metaType + "{}, false\n}\n"
+	if err := g.AddFuncOrMethod(names.ByName, byNameBody); err != nil {
+		return err
+	}
+
+	byTagBody := "func " + names.ByTag + typeParamsDecl + "(tag, value string) (" + metaType + ", bool) {" + NoLint(nolint) + "\n" +
+		"switch tag {\n"
+	for _, tag := range sortedKeys(byTag) {
+		values := byTag[tag]
+		byTagBody += "case " + Quoted(tag) + ":\nswitch value {\n"
+		for _, value := range sortedKeys(values) {
+			byTagBody += "case " + Quoted(value) + ":\nreturn " + metaType + items[values[value]] + ", true\n"
+		}
+		byTagBody += "}\n"
+	}
+	byTagBody += "}\nreturn " + metaType + "{}, false\n}\n"
+	if err := g.AddFuncOrMethod(names.ByTag, byTagBody); err != nil {
+		return err
+	}
+	g.addFunсDelim()
+	return nil
+}
+
+// fieldTags returns the tags of the last field of the path.
+func fieldTags(model *struc.Model, fieldPath []FieldInfo) map[string]string {
+	for _, part := range fieldPath[:len(fieldPath)-1] {
+		model = part.Type.Model
+	}
+	if model == nil {
+		return nil
+	}
+	return model.FieldsTagValue[fieldPath[len(fieldPath)-1].Name]
+}
+
+func tagsMapLiteral(tags map[string]string) string {
+	if len(tags) == 0 {
+		return "nil"
+	}
+	return "map[string]string{" + strings.Join(slice.Convert(sortedKeys(tags), func(tag string) string {
+		return Quoted(tag) + ": " + Quoted(tags[tag])
+	}), ", ") + "}"
+}
+
+func fieldMetaGetter(recType string, fieldPath []FieldInfo) string {
+	recVar := fieldsMetaReceiverVar
+	_, path, conditions := FiledPathAndAccessCheckCondition(recVar, false, false, fieldPath, unique.NewNamesWith(unique.PreInit(recVar)))
+	start, end := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+	return "func(" + recVar + " " + recType + ") any {\n" +
+		"if " + recVar + " == nil {\nreturn nil\n}\n" +
+		start + "return " + path + "\n" + end + op.IfElse(len(conditions) > 0, "return nil\n", "") + "}"
+}
+
+func fieldMetaSetter(recType, fieldType string, fieldPath []FieldInfo) string {
+	recVar, valueVar := fieldsMetaReceiverVar, fieldsMetaSetterValueVar
+	uniqueNames := unique.NewNamesWith(unique.PreInit(recVar, valueVar))
+	typedVar := uniqueNames.Get("val")
+	path, conditions := FieldPathAndParentsCheckCondition(recVar, fieldPath, uniqueNames)
+	start, end := split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+	return "func(" + recVar + " " + recType + ", " + valueVar + " any) bool {\n" +
+		typedVar + ", ok := " + valueVar + ".(" + fieldType + ")\n" +
+		"if " + recVar + " == nil || !ok {\nreturn false\n}\n" +
+		start + path + " = " + typedVar + "\nreturn true\n" + end + op.IfElse(len(conditions) > 0, "return false\n", "") + "}"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
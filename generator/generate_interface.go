@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/op/string_"
+
+	"github.com/m4gshm/fieldr/model/util"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const DefaultInterfaceSuffix = "API"
+
+// MethodSignature returns the method parameters and results, like '(id int) (string, error)'.
+func (g *Generator) MethodSignature(method *types.Func) (string, error) {
+	sig := method.Type().(*types.Signature)
+	signature, err := g.typeString(types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic()))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(signature, "func"), nil
+}
+
+// typeString returns the type string qualified by the package names or aliases of the output file imports, the packages are imported if needed.
+func (g *Generator) typeString(typ types.Type) (string, error) {
+	var err error
+	str := types.TypeString(typ, func(pkg *types.Package) string {
+		alias, aerr := g.GetPackageNameOrAlias(pkg.Name(), pkg.Path())
+		if err == nil {
+			err = aerr
+		}
+		return alias
+	})
+	return str, err
+}
+
+func (g *Generator) GenerateInterface(typ util.TypeNamedOrAlias, name string, methods []*types.Func, nolint bool) (Structure, error) {
+	var (
+		_, typeParamsDecl, _ = typeparams.New(typ.TypeParams(), g.Repack, g.OutPkgPath).IdentDeclNamess()
+		ifaceName            = op.IfElse(name == Autoname, typ.Obj().Name()+DefaultInterfaceSuffix, name)
+		body                 = ifaceName + typeParamsDecl + " interface {" + NoLint(nolint) + "\n"
+	)
+	for _, method := range methods {
+		signature, err := g.MethodSignature(method)
+		if err != nil {
+			return Structure{}, err
+		}
+		body += method.Name() + signature + "\n"
+	}
+	return Structure{Name: ifaceName, Body: body + "}"}, nil
+}
+
+// MethodParams contains the parameters and results of a method prepared for generating a method implementation.
+type MethodParams struct {
+	Names, Types, Results []string
+	Variadic              bool
+}
+
+// GetMethodParams names the method parameters uniquely, unnamed and blank parameters are named by position.
+func (g *Generator) GetMethodParams(method *types.Func, uniqueNames *unique.Names) (MethodParams, error) {
+	sig := method.Type().(*types.Signature)
+	params, results := sig.Params(), sig.Results()
+	p := MethodParams{Variadic: sig.Variadic()}
+	for i := range params.Len() {
+		param := params.At(i)
+		name := param.Name()
+		if len(name) == 0 || name == "_" {
+			name = "arg" + strconv.Itoa(i)
+		}
+		typ, err := g.typeString(param.Type())
+		if err != nil {
+			return MethodParams{}, err
+		}
+		p.Names = append(p.Names, uniqueNames.Get(LegalIdentName(name)))
+		p.Types = append(p.Types, typ)
+	}
+	for i := range results.Len() {
+		typ, err := g.typeString(results.At(i).Type())
+		if err != nil {
+			return MethodParams{}, err
+		}
+		p.Results = append(p.Results, typ)
+	}
+	return p, nil
+}
+
+// Decl returns the parameters declaration, like '(id int, names ...string)'.
+func (p MethodParams) Decl() string {
+	decl := ""
+	for i, name := range p.Names {
+		typ := p.Types[i]
+		if p.Variadic && i == len(p.Names)-1 {
+			typ = "..." + strings.TrimPrefix(typ, "[]")
+		}
+		decl += op.IfElse(i > 0, ", ", "") + name + " " + typ
+	}
+	return "(" + decl + ")"
+}
+
+// Args returns the parameters as call arguments, like 'id, names...'.
+func (p MethodParams) Args() string {
+	return strings.Join(p.Names, ", ") + op.IfElse(p.Variadic, "...", "")
+}
+
+// ResultsDecl returns the results declaration, like '(int, error)'.
+func (p MethodParams) ResultsDecl() string {
+	if len(p.Results) == 1 {
+		return p.Results[0]
+	}
+	return string_.WrapNonEmpty("(", strings.Join(p.Results, ", "), ")")
+}
@@ -0,0 +1,115 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/model/iface"
+	"github.com/m4gshm/fieldr/typeparams"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const DefaultMockSuffix = "Mock"
+const DefaultMockFuncSuffix = "Func"
+const DefaultMockCallsSuffix = "Calls"
+const DefaultMockCallCountSuffix = "CallCount"
+
+// GenerateMock generates a mock struct of the interface and a call arguments struct per method.
+func (g *Generator) GenerateMock(model *iface.Model, name, funcSuffix string, nolint bool) ([]Structure, error) {
+	typ := model.Typ()
+	syncPkg, err := g.GetPackageNameOrAlias("sync", "sync")
+	if err != nil {
+		return nil, err
+	}
+	var (
+		typeParams, typeParamsDecl, typeParamNames = typeparams.New(typ.TypeParams(), g.Repack, g.OutPkgPath).IdentDeclNamess()
+
+		methods       = model.Methods()
+		methodNames   = immutable.NewSet(slice.Convert(methods, (*types.Func).Name)...)
+		mockName      = op.IfElse(name == Autoname, model.TypeName()+DefaultMockSuffix, name)
+		mockType      = mockName + typeParams
+		receiverVar   = "m"
+		mockBody      = mockName + typeParamsDecl + " struct {" + NoLint(nolint) + "\n"
+		callsBody     = "calls struct {\n"
+		mock          = Structure{Name: mockName}
+		callStructs   = make([]Structure, 0, len(methods))
+		reservedNames = append(slice.Of(receiverVar, syncPkg), typeParamNames...)
+	)
+	for _, field := range slice.Of("mu", "calls") {
+		if methodNames.Contains(field) {
+			return nil, fmt.Errorf("mock member '%s' conflicts with the method of '%s'", field, model.TypeName())
+		}
+	}
+	for _, method := range methods {
+		methodName := method.Name()
+		if pkg := method.Pkg(); !method.Exported() && pkg.Path() != g.OutPkgPath {
+			return nil, fmt.Errorf("unexported method '%s' cannot be implemented outside the package %s", methodName, pkg.Path())
+		}
+		var (
+			funcField      = methodName + funcSuffix
+			callsMethod    = methodName + DefaultMockCallsSuffix
+			callCount      = methodName + DefaultMockCallCountSuffix
+			callStructName = mockName + IdentName(methodName, true) + "Call"
+			callStructType = callStructName + typeParams
+		)
+		for _, generated := range slice.Of(funcField, callsMethod, callCount) {
+			if methodNames.Contains(generated) {
+				return nil, fmt.Errorf("mock member '%s' conflicts with the method of '%s'", generated, model.TypeName())
+			}
+		}
+		params, err := g.GetMethodParams(method, unique.NewNamesWith(unique.PreInit(append(reservedNames, mockName, callStructName)...)))
+		if err != nil {
+			return nil, err
+		}
+		funcType := "func" + params.Decl() + " " + params.ResultsDecl()
+		mockBody += funcField + " " + funcType + "\n"
+		callsBody += methodName + " []" + callStructType + "\n"
+
+		callFields := unique.NewNamesWith()
+		callStructFields := ""
+		callInit := callStructType + "{"
+		for i, paramName := range params.Names {
+			field := callFields.Get(LegalIdentName(IdentName(paramName, true)))
+			callStructFields += field + " " + params.Types[i] + "\n"
+			callInit += op.IfElse(i > 0, ", ", "") + field + ": " + paramName
+		}
+		callInit += "}"
+		callStructBody := callStructName + typeParamsDecl + op.IfElse(len(callStructFields) > 0,
+			" struct {"+NoLint(nolint)+"\n"+callStructFields+"}\n", " struct{}"+NoLint(nolint)+"\n")
+		callStructs = append(callStructs, Structure{Name: callStructName, Body: callStructBody})
+
+		methodBody := "func (" + receiverVar + " *" + mockType + ") " + methodName + params.Decl() + " " + params.ResultsDecl() + " {" + NoLint(nolint) + "\n" +
+			receiverVar + ".mu.Lock()\n" +
+			receiverVar + ".calls." + methodName + " = append(" + receiverVar + ".calls." + methodName + ", " + callInit + ")\n" +
+			receiverVar + ".mu.Unlock()\n" +
+			"if " + receiverVar + "." + funcField + " == nil {\n" +
+			"\tpanic(" + strconv.Quote(mockName+"."+methodName+": "+funcField+" is not set") + ")\n}\n" +
+			op.IfElse(len(params.Results) > 0, "return ", "") + receiverVar + "." + funcField + "(" + params.Args() + ")\n}\n"
+		if err := mock.AddMethod(methodName, methodBody); err != nil {
+			return nil, err
+		}
+
+		callsMethodBody := "func (" + receiverVar + " *" + mockType + ") " + callsMethod + "() []" + callStructType + " {" + NoLint(nolint) + "\n" +
+			receiverVar + ".mu.Lock()\n" +
+			"defer " + receiverVar + ".mu.Unlock()\n" +
+			"return append([]" + callStructType + "(nil), " + receiverVar + ".calls." + methodName + "...)\n}\n"
+		if err := mock.AddMethod(callsMethod, callsMethodBody); err != nil {
+			return nil, err
+		}
+
+		callCountBody := "func (" + receiverVar + " *" + mockType + ") " + callCount + "() int {" + NoLint(nolint) + "\n" +
+			receiverVar + ".mu.Lock()\n" +
+			"defer " + receiverVar + ".mu.Unlock()\n" +
+			"return len(" + receiverVar + ".calls." + methodName + ")\n}\n"
+		if err := mock.AddMethod(callCount, callCountBody); err != nil {
+			return nil, err
+		}
+	}
+	mock.Body = mockBody + "mu " + GetTypeName("Mutex", syncPkg) + "\n" + callsBody + "}\n}\n"
+	return append([]Structure{mock}, callStructs...), nil
+}
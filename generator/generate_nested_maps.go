@@ -0,0 +1,209 @@
+package generator
+
+import (
+	"go/token"
+	"go/types"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/op/delay/replace"
+	"github.com/m4gshm/gollections/op/delay/string_/wrap"
+	"github.com/m4gshm/gollections/slice/split"
+
+	"github.com/m4gshm/fieldr/logger"
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+// nestedMaps generates the functions that convert nested structs to map[string]any trees.
+type nestedMaps struct {
+	funcPrefix string
+	rewriter   *CodeRewriter
+	nolint     bool
+	models     struc.HandledStructs
+	funcs      map[string]struct{}
+}
+
+func newNestedMaps(funcName string, rewriter *CodeRewriter, nolint bool) *nestedMaps {
+	return &nestedMaps{
+		funcPrefix: IdentName(funcName, false),
+		rewriter:   rewriter,
+		nolint:     nolint,
+		models:     struc.HandledStructs{},
+		funcs:      map[string]struct{}{},
+	}
+}
+
+// nestedMapInit returns the map element assignment of the field value.
+// The rewriter gets the field value, the rewritten value is used as is even if the reference is required, a struct value is converted to map[string]any, a slice of structs to []map[string]any,
+// nil pointers and slices are stored as nil.
+func (g *Generator) nestedMapInit(nested *nestedMaps, model *struc.Model, fieldPath []FieldInfo, mapVar, key, fieldRef string, ref bool) (string, error) {
+	valueRef := fieldRef
+	if ref {
+		valueRef = "&" + fieldRef
+	}
+	assign := mapVar + "[" + key + "]= "
+	if rewritten, ok, err := nested.rewriter.Transform(g, model, fieldPath, fieldRef); err != nil {
+		return "", err
+	} else if ok {
+		return assign + rewritten + "\n", nil
+	}
+	value, nilable, ok, err := g.nestedMapValue(nested, model, fieldPath[len(fieldPath)-1], fieldRef)
+	if err != nil {
+		return "", err
+	} else if !ok {
+		return assign + valueRef + "\n", nil
+	} else if nilable {
+		return "if " + fieldRef + " != nil {\n" + assign + value + "\n} else {\n" + assign + "nil\n}\n", nil
+	}
+	return assign + value + "\n", nil
+}
+
+// nestedMapValue returns the conversion expression of a struct or a slice of structs field.
+func (g *Generator) nestedMapValue(nested *nestedMaps, model *struc.Model, field FieldInfo, fieldRef string) (string, bool, bool, error) {
+	if fieldModel, deep, err := g.nestedModel(nested, model, field.Type.Type); err != nil {
+		return "", false, false, err
+	} else if fieldModel != nil {
+		funcName, err := g.nestedMapFunc(nested, fieldModel)
+		if err != nil {
+			return "", false, false, err
+		} else if deep == 0 {
+			return funcName + "(&" + fieldRef + ")", false, true, nil
+		}
+		return funcName + "(" + fieldRef + ")", true, true, nil
+	}
+	if slice, ok := field.Type.Type.Underlying().(*types.Slice); ok {
+		if elemModel, deep, err := g.nestedModel(nested, model, slice.Elem()); err != nil {
+			return "", false, false, err
+		} else if elemModel != nil {
+			funcName, err := g.nestedMapsSliceFunc(nested, elemModel, deep > 0)
+			if err != nil {
+				return "", false, false, err
+			}
+			return funcName + "(" + fieldRef + ")", true, true, nil
+		}
+	}
+	return "", false, false, nil
+}
+
+// nestedModel returns the model of a struct or a struct pointer type that can be converted to a map.
+// Generic structs and structs without exported fields like time.Time are not converted.
+func (g *Generator) nestedModel(nested *nestedMaps, model *struc.Model, typ types.Type) (*struc.Model, int, error) {
+	named, deep := util.GetStructTypeNamed(typ)
+	if named == nil || deep > 1 || hasTypeParams(named) {
+		return nil, 0, nil
+	}
+	fieldModel, ok := nested.models[named]
+	if !ok {
+		var err error
+		if fieldModel, err = struc.NewModel(model.OutPkgPath, nested.models, named, model.TypFile); err != nil {
+			return nil, 0, err
+		}
+	}
+	if !slices.ContainsFunc(fieldModel.FieldNames, token.IsExported) {
+		logger.Debugf("struct %s without exported fields is not converted to map", fieldModel.TypeName())
+		return nil, 0, nil
+	}
+	return fieldModel, deep, nil
+}
+
+// nestedMapFunc generates the function that converts the struct exported fields to map[string]any.
+func (g *Generator) nestedMapFunc(nested *nestedMaps, model *struc.Model) (string, error) {
+	funcName := nested.funcPrefix + g.nestedTypeNamePart(model)
+	if _, ok := nested.funcs[funcName]; ok {
+		return funcName, nil
+	}
+	nested.funcs[funcName] = struct{}{}
+	typ, err := g.repackedTypeString(types.NewPointer(model.Typ))
+	if err != nil {
+		return "", err
+	}
+	const recVar, mapVar = "v", "m"
+	inits := ""
+	for _, fieldPath := range nestedMapFieldPaths(model, nil, map[string]struct{}{}, struc.HandledStructs{model.Typ: model}) {
+		var (
+			uniqueNames                          = unique.NewNamesWith(unique.PreInit(recVar))
+			fieldRef, conditions                 = FieldPathAndParentsCheckCondition(recVar, fieldPath, uniqueNames)
+			varsConditionStart, varsConditionEnd = split.AndReduce(conditions, wrap.By("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+		)
+		init, err := g.nestedMapInit(nested, model, fieldPath, mapVar, strconv.Quote(fieldPath[len(fieldPath)-1].Name), fieldRef, false)
+		if err != nil {
+			return "", err
+		}
+		inits += varsConditionStart + init + varsConditionEnd
+	}
+	body := "func " + funcName + "(" + recVar + " " + typ + ") map[string]any {" + NoLint(nested.nolint) + "\n" +
+		"if " + recVar + " == nil {\nreturn nil\n}\n" +
+		mapVar + " := map[string]any{}\n" +
+		inits +
+		"return " + mapVar + "\n}\n"
+	return funcName, g.AddFuncOrMethod(funcName, body)
+}
+
+// nestedMapFieldPaths returns the paths of the exported fields of the struct.
+// The fields of embedded structs are flattened like the top level map keys, a field shadows the fields of the same name of embedded structs.
+func nestedMapFieldPaths(model *struc.Model, parents []FieldInfo, shadowed map[string]struct{}, visited struc.HandledStructs) [][]FieldInfo {
+	declared := maps.Clone(shadowed)
+	for fieldName, fieldType := range model.FieldsNameAndType {
+		if !fieldType.Embedded || fieldType.Model == nil {
+			declared[fieldName] = struct{}{}
+		}
+	}
+	paths := [][]FieldInfo{}
+	for fieldName, fieldType := range model.FieldsNameAndType {
+		if !token.IsExported(fieldName) {
+			continue
+		}
+		fieldPath := append(slices.Clone(parents), FieldInfo{Name: fieldName, Type: fieldType})
+		if fieldModel := fieldType.Model; fieldType.Embedded && fieldModel != nil {
+			if _, ok := visited[fieldModel.Typ]; ok {
+				logger.Debugf("recursive embedded struct %s is not flattened", fieldModel.TypeName())
+				continue
+			}
+			subvisited := maps.Clone(visited)
+			subvisited[fieldModel.Typ] = fieldModel
+			paths = append(paths, nestedMapFieldPaths(fieldModel, fieldPath, declared, subvisited)...)
+		} else if _, ok := shadowed[fieldName]; !ok {
+			paths = append(paths, fieldPath)
+		}
+	}
+	return paths
+}
+
+// nestedMapsSliceFunc generates the function that converts the slice of structs or struct pointers to []map[string]any.
+func (g *Generator) nestedMapsSliceFunc(nested *nestedMaps, elemModel *struc.Model, elemRef bool) (string, error) {
+	elemFuncName, err := g.nestedMapFunc(nested, elemModel)
+	if err != nil {
+		return "", err
+	}
+	var elemType types.Type = elemModel.Typ
+	funcName, elem := elemFuncName+"Slice", "&values[i]"
+	if elemRef {
+		elemType = types.NewPointer(elemType)
+		funcName, elem = elemFuncName+"RefSlice", "values[i]"
+	}
+	if _, ok := nested.funcs[funcName]; ok {
+		return funcName, nil
+	}
+	nested.funcs[funcName] = struct{}{}
+	typ, err := g.repackedTypeString(types.NewSlice(elemType))
+	if err != nil {
+		return "", err
+	}
+	body := "func " + funcName + "(values " + typ + ") []map[string]any {" + NoLint(nested.nolint) + "\n" +
+		"if values == nil {\nreturn nil\n}\n" +
+		"maps := make([]map[string]any, len(values))\n" +
+		"for i := range values {\nmaps[i] = " + elemFuncName + "(" + elem + ")\n}\n" +
+		"return maps\n}\n"
+	return funcName, g.AddFuncOrMethod(funcName, body)
+}
+
+func (g *Generator) nestedTypeNamePart(model *struc.Model) string {
+	if pkg := model.Package(); pkg != nil && pkg.Path() != g.OutPkgPath {
+		return camel(pkg.Name()) + model.TypeName()
+	}
+	return model.TypeName()
+}
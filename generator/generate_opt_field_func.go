@@ -8,35 +8,100 @@ import (
 	"github.com/m4gshm/fieldr/model/util"
 	"github.com/m4gshm/fieldr/typeparams"
 	"github.com/m4gshm/fieldr/unique"
+	"github.com/m4gshm/gollections/op"
 	"github.com/m4gshm/gollections/slice"
 )
 
-func (g *Generator) GenerateOptionFieldFunc(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType, outPkgPath string, nolint bool, fieldParts []FieldInfo) string {
+const DefaultOptionTypeSuffix = "Option"
+const DefaultOptionStructSuffix = "Option"
+const DefaultOptionApplyMethod = "apply"
+
+func (g *Generator) GenerateOptionFieldFunc(
+	model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType, outPkgPath string, nolint bool, fieldParts []FieldInfo,
+	returnErr bool, validate string,
+) string {
 	typeName := "*" + GetTypeName(model.TypeName(), pkgName)
 	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar))
 	params := typeparams.New(model.Typ.TypeParams(), g.Repack, outPkgPath)
 	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
 	slice.ForEach(paramNames, uniqueNames.Add)
 
-	accessInfo := GetFieldConditionalPartsAccessInfo(receiverVar, fieldParts, uniqueNames)
-	variableName := accessInfo.ShortVar
+	variableName, funcBody := optionFieldPathInit(receiverVar, outPkgPath, fieldParts, uniqueNames)
+
+	arg := uniqueNames.Get(LegalIdentName(ArgName(fieldName)))
+	optType := "func (" + receiverVar + " " + typeName + typeParams + ")" + op.IfElse(returnErr, " error", "")
+
+	result := "func " + methodName + typeParamsDecl + "(" + arg + " " + fieldType + ") " + optType +
+		" {" + NoLint(nolint) + "\n" + "return " + optType + " {" + optionValidation(validate, arg, uniqueNames) + funcBody +
+		variableName + "." + fieldName + "=" + arg + "\n" + op.IfElse(returnErr, "return nil\n", "") + "}\n" + "}\n"
+	return result
+}
+
+// GenerateOptionFieldStruct generates the option function of the field that returns the option struct implementing the option interface.
+func (g *Generator) GenerateOptionFieldStruct(
+	model *struc.Model, pkgName, receiverVar, funcName, fieldName, fieldType, optionType, applyMethod, outPkgPath string,
+	nolint bool, fieldParts []FieldInfo, returnErr bool, validate string,
+) (string, Structure, error) {
+	fmtPkg, err := g.GetPackageNameOrAlias("fmt", "fmt")
+	if err != nil {
+		return "", Structure{}, err
+	}
+	typeName := "*" + GetTypeName(model.TypeName(), pkgName)
+	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar, fmtPkg))
+	params := typeparams.New(model.Typ.TypeParams(), g.Repack, outPkgPath)
+	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
+	slice.ForEach(paramNames, uniqueNames.Add)
+	optionVar := uniqueNames.Get("o")
 
-	funcBody := ""
+	var (
+		structName = IdentName(funcName, false) + DefaultOptionStructSuffix
+		structType = structName + typeParams
+		valueField = "value"
+		value      = optionVar + "." + valueField
+	)
+	variableName, setBody := optionFieldPathInit(receiverVar, outPkgPath, fieldParts, uniqueNames)
+	option := Structure{
+		Name: structName,
+		Body: structName + typeParamsDecl + " struct {" + NoLint(nolint) + "\n" + valueField + " " + fieldType + "\n}\n",
+	}
+	applyBody := "func (" + optionVar + " " + structType + ") " + applyMethod + "(" + receiverVar + " " + typeName + typeParams + ")" +
+		op.IfElse(returnErr, " error", "") + " {" + NoLint(nolint) + "\n" + optionValidation(validate, value, uniqueNames) + setBody +
+		variableName + "." + fieldName + "=" + value + "\n" + op.IfElse(returnErr, "return nil\n", "") + "}\n"
+	if err := option.AddMethod(applyMethod, applyBody); err != nil {
+		return "", Structure{}, err
+	}
+	stringBody := "func (" + optionVar + " " + structType + ") String() string {" + NoLint(nolint) + "\n" +
+		"return " + GetTypeName("Sprintf", fmtPkg) + "(" + strconv.Quote(funcName+"(%v)") + ", " + value + ")\n}\n"
+	if err := option.AddMethod("String", stringBody); err != nil {
+		return "", Structure{}, err
+	}
+
+	arg := uniqueNames.Get(LegalIdentName(ArgName(fieldName)))
+	funcBody := "func " + funcName + typeParamsDecl + "(" + arg + " " + fieldType + ") " + optionType + typeParams +
+		" {" + NoLint(nolint) + "\n" + "return " + structType + "{" + valueField + ": " + arg + "}\n}\n"
+	return funcBody, option, nil
+}
+
+// optionFieldPathInit returns the variable of the field owner and the code that initializes nil embedded structs on the field path.
+func optionFieldPathInit(receiverVar, outPkgPath string, fieldParts []FieldInfo, uniqueNames *unique.Names) (string, string) {
+	accessInfo := GetFieldConditionalPartsAccessInfo(receiverVar, fieldParts, uniqueNames)
+	body := ""
 	for _, accessPathPart := range accessInfo.AccessPathParts {
 		shortVar := accessPathPart.ShortVar
 		typ := accessPathPart.Type.Type
 		newExpr := generateNewObjectExpr(typ, outPkgPath, shortVar)
 		newIfNilExpr := shortVar + " := " + accessPathPart.FieldPath + "\nif " + shortVar + " == nil " + "{\n" + newExpr + "\n" + accessPathPart.FieldPath + " = " + shortVar + "}\n"
-		funcBody += newIfNilExpr
+		body += newIfNilExpr
 	}
+	return accessInfo.ShortVar, body
+}
 
-	arg := uniqueNames.Get(LegalIdentName(ArgName(fieldName)))
-	optType := "func (" + receiverVar + " " + typeName + typeParams + ")"
-
-	result := "func " + methodName + typeParamsDecl + "(" + arg + " " + fieldType + ") " + optType +
-		" {" + NoLint(nolint) + "\n" + "return " + optType + " {" + funcBody +
-		variableName + "." + fieldName + "=" + arg + "\n" + "}\n" + "}\n"
-	return result
+func optionValidation(validate, value string, uniqueNames *unique.Names) string {
+	if len(validate) == 0 {
+		return ""
+	}
+	errVar := uniqueNames.Get("err")
+	return "if " + errVar + " := " + validate + "(" + value + "); " + errVar + " != nil {\nreturn " + errVar + "\n}\n"
 }
 
 func generateNewObjectExpr(typ types.Type, outPkgPath string, receiverVariable string) string {
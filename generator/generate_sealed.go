@@ -0,0 +1,195 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+
+	"github.com/m4gshm/gollections/op"
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/logger"
+	"github.com/m4gshm/fieldr/model/iface"
+	"github.com/m4gshm/fieldr/model/util"
+	"github.com/m4gshm/fieldr/unique"
+)
+
+const DefaultSealedMarkerPrefix = "is"
+const DefaultSealedVisitPrefix = "Visit"
+const DefaultSealedMarshalPrefix = "Marshal"
+const DefaultSealedUnmarshalPrefix = "Unmarshal"
+const DefaultSealedJSONSuffix = "JSON"
+
+// SealedMarkerName returns the marker method name of the sealed interface.
+func SealedMarkerName(typeName, name string) string {
+	return op.IfElse(name == Autoname, DefaultSealedMarkerPrefix+IdentName(typeName, true), name)
+}
+
+// GenerateSealedMarker returns the marker method of the variant or empty strings if the variant already declares the marker method.
+func (g *Generator) GenerateSealedMarker(model *iface.Model, variant iface.Variant, name string, nolint bool) (string, string, error) {
+	obj := variant.Typ.Obj()
+	if pkg := obj.Pkg(); pkg.Path() != g.OutPkgPath {
+		return "", "", fmt.Errorf("marker method of '%s' cannot be generated outside the package %s", obj.Name(), pkg.Path())
+	}
+	funcName := SealedMarkerName(model.TypeName(), name)
+	if declared, _, _ := types.LookupFieldOrMethod(variant.Typ, true, obj.Pkg(), funcName); declared != nil && !g.isOutFilePos(declared.Pos()) {
+		logger.Debugf("variant %s already declares the marker method %s", obj.Name(), funcName)
+		return "", "", nil
+	}
+	var (
+		typeName     = obj.Name()
+		receiverType = op.IfElse(variant.Ref, "*", "") + typeName
+		body         = "func (" + receiverType + ") " + funcName + "() {" + op.IfElse(nolint, " "+NoLint(nolint)+"\n", "") + "}\n"
+	)
+	return MethodName(typeName, funcName), body, nil
+}
+
+func (g *Generator) GenerateSealedVisit(model *iface.Model, variants []iface.Variant, name string, export, nolint bool) (string, string, error) {
+	ifaceType, err := g.accessibleTypeName(model.Typ())
+	if err != nil {
+		return "", "", err
+	}
+	variantTypes, err := g.sealedVariantTypeNames(variants)
+	if err != nil {
+		return "", "", err
+	}
+	var (
+		uniqueNames = unique.NewNamesWith(unique.PreInit(slice.Convert(append(variants, iface.Variant{Typ: model.Typ()}), sealedObjName)...))
+		resultType  = uniqueNames.Get("R")
+		valueVar    = uniqueNames.Get("v")
+		resultVar   = uniqueNames.Get("r")
+		typedVar    = uniqueNames.Get("vt")
+		args        = []string{valueVar + " " + ifaceType}
+		content     = "switch " + typedVar + " := " + valueVar + ".(type) {\n"
+	)
+	for i, variant := range variants {
+		variantType := variantTypes[i]
+		callback := uniqueNames.Get(LegalIdentName(ArgName(variant.Typ.Obj().Name())))
+		args = append(args, callback+" func("+op.IfElse(variant.Ref, "*", "")+variantType+") "+resultType)
+		if variant.Ref {
+			content += "case *" + variantType + ":\n\tif " + callback + " != nil {\n\t\treturn " + callback + "(" + typedVar + ")\n\t}\n"
+		} else {
+			content += "case " + variantType + ":\n\tif " + callback + " != nil {\n\t\treturn " + callback + "(" + typedVar + ")\n\t}\n"
+			content += "case *" + variantType + ":\n\tif " + callback + " != nil && " + typedVar + " != nil {\n\t\treturn " + callback + "(*" + typedVar + ")\n\t}\n"
+		}
+	}
+	content += "}\nreturn " + resultVar
+	funcName := IdentName(op.IfElse(name == Autoname, DefaultSealedVisitPrefix+IdentName(model.TypeName(), true), name), export)
+	return funcName, FuncBodyWithArgs(funcName+"["+resultType+" any]", args, " ("+resultVar+" "+resultType+")", nolint, content), nil
+}
+
+func (g *Generator) GenerateSealedMarshalJSON(model *iface.Model, variants []iface.Variant, name, discriminator string, export, nolint bool) (string, string, error) {
+	ifaceType, err := g.accessibleTypeName(model.Typ())
+	if err != nil {
+		return "", "", err
+	}
+	variantTypes, err := g.sealedVariantTypeNames(variants)
+	if err != nil {
+		return "", "", err
+	}
+	jsonPkg, err := g.GetPackageNameOrAlias("json", "encoding/json")
+	if err != nil {
+		return "", "", err
+	}
+	fmtPkg, err := g.GetPackageNameOrAlias("fmt", "fmt")
+	if err != nil {
+		return "", "", err
+	}
+	var (
+		uniqueNames      = unique.NewNamesWith(unique.PreInit(append(slice.Convert(append(variants, iface.Variant{Typ: model.Typ()}), sealedObjName), jsonPkg, fmtPkg)...))
+		valueVar         = uniqueNames.Get("v")
+		discriminatorVar = uniqueNames.Get("discriminator")
+		dataVar          = uniqueNames.Get("data")
+		headVar          = uniqueNames.Get("head")
+		errVar           = uniqueNames.Get("err")
+		typeName         = model.TypeName()
+		content          = "var " + discriminatorVar + " string\nswitch " + valueVar + ".(type) {\ncase nil:\n\treturn []byte(\"null\"), nil\n"
+	)
+	for i, variant := range variants {
+		variantType := variantTypes[i]
+		content += "case " + op.IfElse(variant.Ref, "", variantType+", ") + "*" + variantType + ":\n\t" +
+			discriminatorVar + " = " + strconv.Quote(variant.Typ.Obj().Name()) + "\n"
+	}
+	content += "default:\n\treturn nil, " + fmtPkg + ".Errorf(\"unexpected variant %T of " + typeName + "\", " + valueVar + ")\n}\n" +
+		dataVar + ", " + errVar + " := " + jsonPkg + ".Marshal(" + valueVar + ")\n" +
+		"if " + errVar + " != nil {\n\treturn nil, " + errVar + "\n}\n" +
+		"if len(" + dataVar + ") < 2 || " + dataVar + "[0] != '{' {\n\treturn nil, " + fmtPkg + ".Errorf(\"variant %T of " + typeName + " is not marshaled to a JSON object\", " + valueVar + ")\n}\n" +
+		headVar + " := `{" + strconv.Quote(discriminator) + ":\"` + " + discriminatorVar + " + `\"`\n" +
+		"if len(" + dataVar + ") > 2 {\n\t" + headVar + " += \",\"\n}\n" +
+		"return append([]byte(" + headVar + "), " + dataVar + "[1:]...), nil"
+	funcName := IdentName(op.IfElse(name == Autoname, DefaultSealedMarshalPrefix+IdentName(typeName, true)+DefaultSealedJSONSuffix, name), export)
+	return funcName, FuncBodyWithArgs(funcName, slice.Of(valueVar+" "+ifaceType), " ([]byte, error)", nolint, content), nil
+}
+
+func (g *Generator) GenerateSealedUnmarshalJSON(model *iface.Model, variants []iface.Variant, name, discriminator string, export, nolint bool) (string, string, error) {
+	ifaceType, err := g.accessibleTypeName(model.Typ())
+	if err != nil {
+		return "", "", err
+	}
+	variantTypes, err := g.sealedVariantTypeNames(variants)
+	if err != nil {
+		return "", "", err
+	}
+	jsonPkg, err := g.GetPackageNameOrAlias("json", "encoding/json")
+	if err != nil {
+		return "", "", err
+	}
+	fmtPkg, err := g.GetPackageNameOrAlias("fmt", "fmt")
+	if err != nil {
+		return "", "", err
+	}
+	var (
+		uniqueNames      = unique.NewNamesWith(unique.PreInit(append(slice.Convert(append(variants, iface.Variant{Typ: model.Typ()}), sealedObjName), jsonPkg, fmtPkg)...))
+		dataVar          = uniqueNames.Get("data")
+		discriminatorVar = uniqueNames.Get("discriminator")
+		valueVar         = uniqueNames.Get("v")
+		errVar           = uniqueNames.Get("err")
+		typeName         = model.TypeName()
+		content          = "var " + discriminatorVar + " *struct {\n\tValue *string `json:" + strconv.Quote(discriminator) + "`\n}\n" +
+			"if " + errVar + " := " + jsonPkg + ".Unmarshal(" + dataVar + ", &" + discriminatorVar + "); " + errVar + " != nil {\n\treturn nil, " + errVar + "\n}\n" +
+			"if " + discriminatorVar + " == nil {\n\treturn nil, nil\n}\n" +
+			"if " + discriminatorVar + ".Value == nil {\n\treturn nil, " + fmtPkg + ".Errorf(\"no discriminator '" + discriminator + "' of " + typeName + "\")\n}\n" +
+			"switch *" + discriminatorVar + ".Value {\n"
+	)
+	for i, variant := range variants {
+		variantType := variantTypes[i]
+		content += "case " + strconv.Quote(variant.Typ.Obj().Name()) + ":\n"
+		if variant.Ref {
+			content += "\t" + valueVar + " := new(" + variantType + ")\n" +
+				"\tif " + errVar + " := " + jsonPkg + ".Unmarshal(" + dataVar + ", " + valueVar + "); " + errVar + " != nil {\n\t\treturn nil, " + errVar + "\n\t}\n"
+		} else {
+			content += "\tvar " + valueVar + " " + variantType + "\n" +
+				"\tif " + errVar + " := " + jsonPkg + ".Unmarshal(" + dataVar + ", &" + valueVar + "); " + errVar + " != nil {\n\t\treturn nil, " + errVar + "\n\t}\n"
+		}
+		content += "\treturn " + valueVar + ", nil\n"
+	}
+	content += "default:\n\treturn nil, " + fmtPkg + ".Errorf(\"unexpected discriminator %q of " + typeName + "\", *" + discriminatorVar + ".Value)\n}"
+	funcName := IdentName(op.IfElse(name == Autoname, DefaultSealedUnmarshalPrefix+IdentName(typeName, true)+DefaultSealedJSONSuffix, name), export)
+	return funcName, FuncBodyWithArgs(funcName, slice.Of(dataVar+" []byte"), " ("+ifaceType+", error)", nolint, content), nil
+}
+
+func (g *Generator) accessibleTypeName(typ util.TypeNamedOrAlias) (string, error) {
+	obj := typ.Obj()
+	pkg := obj.Pkg()
+	pkgName, err := g.GetPackageNameOrAlias(pkg.Name(), pkg.Path())
+	if err != nil {
+		return "", err
+	} else if len(pkgName) > 0 && !obj.Exported() {
+		return "", fmt.Errorf("type '%s' is not accessible outside the package %s", obj.Name(), pkg.Path())
+	}
+	return GetTypeName(obj.Name(), pkgName), nil
+}
+
+func (g *Generator) sealedVariantTypeNames(variants []iface.Variant) ([]string, error) {
+	names := make([]string, len(variants))
+	for i, variant := range variants {
+		name, err := g.accessibleTypeName(variant.Typ)
+		if err != nil {
+			return nil, err
+		}
+		names[i] = name
+	}
+	return names, nil
+}
+
+func sealedObjName(variant iface.Variant) string { return variant.Typ.Obj().Name() }
@@ -48,6 +48,7 @@ type Generator struct {
 	OutPkgPath   string
 	OutPkgTypes  *types.Package
 	outBuildTags string
+	buildTags    []string
 
 	body *bytes.Buffer
 
@@ -56,6 +57,7 @@ type Generator struct {
 
 	varNames     []string
 	varValues    map[string]string
+	varTypes     map[string]string
 	typeNames    []string
 	typeValues   map[string]string
 	structNames  []string
@@ -126,12 +128,13 @@ func (c constants) nvMap() map[string]string {
 	return r
 }
 
-func New(fileSet *token.FileSet, name, outBuildTags string, outFile *ast.File, outFileInfo *token.File, pkgPath string, pkgTypes *types.Package) (*Generator, error) {
+func New(fileSet *token.FileSet, name, outBuildTags string, buildTags []string, outFile *ast.File, outFileInfo *token.File, pkgPath string, pkgTypes *types.Package) (*Generator, error) {
 	rewriteOutFile := isRewrite(outFile, outFileInfo, generatedMarker(name))
 	g := &Generator{
 		name:           name,
 		fileSet:        fileSet,
 		outBuildTags:   outBuildTags,
+		buildTags:      buildTags,
 		outFile:        outFile,
 		outFileInfo:    outFileInfo,
 		OutPkgTypes:    pkgTypes,
@@ -140,6 +143,7 @@ func New(fileSet *token.FileSet, name, outBuildTags string, outFile *ast.File, o
 		constants:      map[string]constant{},
 		varNames:       []string{},
 		varValues:      map[string]string{},
+		varTypes:       map[string]string{},
 		typeNames:      []string{},
 		typeValues:     map[string]string{},
 		structNames:    []string{},
@@ -211,6 +215,10 @@ func OutPackageName(outPackageName string, outPackage *packages.Package) string
 	return outPackageName
 }
 
+func (g *Generator) Position(pos token.Pos) token.Position {
+	return g.fileSet.Position(pos)
+}
+
 func (g *Generator) GetPackageNameOrAlias(pkgName, pkgPath string) (string, error) {
 	needImport := pkgPath != g.OutPkgPath
 	logger.Debugf("GetPackageNameOrAlias pkgName %s, pkgPath %s, needImport %t", pkgName, pkgPath, needImport)
@@ -265,8 +273,10 @@ func (g *Generator) GetPackageNameOrAlias(pkgName, pkgPath string) (string, erro
 		if name == pkgAlias {
 			importAlias = ""
 		}
-		if _, err := g.AddImport(pkgPath, importAlias, nil); err != nil {
+		if alias, err := g.AddImport(pkgPath, importAlias, nil); err != nil {
 			return "", err
+		} else if len(alias) > 0 {
+			pkgAlias = alias
 		}
 	}
 	return pkgAlias, nil
@@ -343,6 +353,11 @@ func isRewrite(outFile *ast.File, outFileInfo *token.File, generatedMarker strin
 	return ok && strings.HasPrefix(comment.Text(), generatedMarker)
 }
 
+// isOutFilePos checks whether the position belongs to the output file.
+func (g *Generator) isOutFilePos(pos token.Pos) bool {
+	return g.outFileInfo != nil && int(pos) >= g.outFileInfo.Base() && int(pos) <= g.outFileInfo.Base()+g.outFileInfo.Size()
+}
+
 func (g *Generator) findImportPackageAlias(pkgPath string, outFile *ast.File) (string, bool, error) {
 	if outFile == nil {
 		return "", false, nil
@@ -433,7 +448,7 @@ func (g *Generator) getInjectChunks(outFile *ast.File, base int) (map[int]map[in
 					case *ast.TypeSpec:
 						switch st.Type.(type) {
 						case *ast.Ident, *ast.ArrayType:
-						case *ast.StructType:
+						case *ast.StructType, *ast.InterfaceType:
 							struc = true
 						default:
 							continue
@@ -689,7 +704,11 @@ func (g *Generator) writeVars() {
 			continue
 		}
 		value := g.varValues[name]
-		g.writeBody("%v=%v", name, value)
+		if typ := g.varTypes[name]; len(typ) > 0 {
+			g.writeBody("%v %v=%v", name, typ, value)
+		} else {
+			g.writeBody("%v=%v", name, value)
+		}
 		g.writeBody("\n")
 	}
 	if len(g.varNames) > 0 {
@@ -815,6 +834,12 @@ func (g *Generator) addConstDelim() {
 	}
 }
 
+func (g *Generator) addVarDelim() {
+	if len(g.varNames) > 0 {
+		g.varNames = append(g.varNames, "")
+	}
+}
+
 func (g *Generator) addFunсDelim() {
 	if len(g.funcNames) > 0 {
 		g.funcNames = append(g.funcNames, "")
@@ -831,6 +856,18 @@ func (g *Generator) addConst(name, value, typ string) error {
 	return nil
 }
 
+// AddVar registers a package level variable with the value expression and optional type
+func (g *Generator) AddVar(name, value, typ string) error {
+	if exists, ok := g.varValues[name]; ok && (exists != value || g.varTypes[name] != typ) {
+		return errors.Errorf("duplicated variable with different value; var %s, exist '%s', new '%s'", name, exists, value)
+	} else if !ok {
+		g.varNames = append(g.varNames, name)
+		g.varValues[name] = value
+		g.varTypes[name] = typ
+	}
+	return nil
+}
+
 func (g *Generator) AddFuncDecl(node *ast.FuncDecl) error {
 	funcName := FuncDeclName(node)
 	if exists, ok := g.funcBodies[funcName]; ok {
@@ -1051,6 +1088,8 @@ func (g *Generator) Repack(typ types.Type, basePackagePath string) (types.Type,
 		obj := tt.Obj()
 		if repaked, err := g.RepackObj(tt.Obj(), basePackagePath); err != nil {
 			return nil, err
+		} else if targs := tt.TypeArgs(); targs.Len() > 0 {
+			return g.repackInstance(tt, repaked, basePackagePath)
 		} else if repaked != obj {
 			methods := make([]*types.Func, tt.NumMethods())
 			for i := range methods {
@@ -1058,6 +1097,13 @@ func (g *Generator) Repack(typ types.Type, basePackagePath string) (types.Type,
 			}
 			return types.NewNamed(repaked, tt.Underlying(), methods), nil
 		}
+	case *types.Alias:
+		obj := tt.Obj()
+		if repaked, err := g.RepackObj(obj, basePackagePath); err != nil {
+			return nil, err
+		} else if repaked != obj && tt.TypeArgs().Len() == 0 {
+			return types.NewAlias(repaked, tt.Rhs()), nil
+		}
 	case *types.Pointer:
 		e := tt.Elem()
 		if re, err := g.Repack(e, basePackagePath); err != nil {
@@ -1128,6 +1174,29 @@ func (g *Generator) Repack(typ types.Type, basePackagePath string) (types.Type,
 	return typ, nil
 }
 
+// repackInstance repacks the type arguments of the generic type instance.
+func (g *Generator) repackInstance(typ *types.Named, repaked *types.TypeName, basePackagePath string) (types.Type, error) {
+	targs := typ.TypeArgs()
+	repacked := repaked != typ.Obj()
+	args, err := seq.Conv(seq.OfIndexed(targs.Len(), targs.At), func(t types.Type) (types.Type, error) {
+		rt, err := g.Repack(t, basePackagePath)
+		repacked = repacked || rt != t
+		return rt, err
+	}).Slice()
+	if err != nil {
+		return nil, err
+	} else if !repacked {
+		return typ, nil
+	}
+	origin := typ.Origin()
+	tparams := origin.TypeParams()
+	generic := types.NewNamed(repaked, origin.Underlying(), nil)
+	generic.SetTypeParams(seq.Convert(seq.OfIndexed(tparams.Len(), tparams.At), func(tp *types.TypeParam) *types.TypeParam {
+		return types.NewTypeParam(types.NewTypeName(tp.Obj().Pos(), tp.Obj().Pkg(), tp.Obj().Name(), nil), tp.Constraint())
+	}).Slice())
+	return types.Instantiate(nil, generic, args, false)
+}
+
 func (g *Generator) GetFullFieldTypeName(fieldType struc.FieldType, baseType bool) (string, error) {
 	typ, err := g.Repack(fieldType.Type, g.OutPkgPath)
 	if err != nil {
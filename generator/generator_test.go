@@ -27,7 +27,7 @@ func Test_ArgName(t *testing.T) {
 }
 
 func Test_AddImport(t *testing.T) {
-	g, err := New(token.NewFileSet(), "test", "", nil, nil, "test", nil)
+	g, err := New(token.NewFileSet(), "test", "", nil, nil, nil, "test", nil)
 	assert.NoError(t, err)
 
 	alias, err := g.AddImport("test", "", nil)
@@ -1,6 +1,9 @@
 package generator
 
 import (
+	"go/types"
+	"strings"
+
 	"github.com/m4gshm/gollections/expr/get"
 	"github.com/m4gshm/gollections/op"
 	"github.com/m4gshm/gollections/op/delay/replace"
@@ -14,28 +17,127 @@ import (
 	"github.com/m4gshm/fieldr/unique"
 )
 
-func (g *Generator) GenerateSetter(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType string, nolint bool, isReceiverReference bool, fieldParts []FieldInfo) string {
+const (
+	MutexTypeName   = "Mutex"
+	RWMutexTypeName = "RWMutex"
+)
+
+var atomicValueTypes = map[string]types.Type{
+	"Bool":    types.Typ[types.Bool],
+	"Int32":   types.Typ[types.Int32],
+	"Int64":   types.Typ[types.Int64],
+	"Uint32":  types.Typ[types.Uint32],
+	"Uint64":  types.Typ[types.Uint64],
+	"Uintptr": types.Typ[types.Uintptr],
+	"Value":   types.Universe.Lookup("any").Type(),
+}
+
+// FieldAccess describes how accessors read and write the field.
+type FieldAccess struct {
+	// Lock is the mutex field name of the receiver that guards accessors.
+	Lock string
+	// RWLock means the lock is sync.RWMutex and getters take the read lock.
+	RWLock bool
+	// Atomic means the field has a sync/atomic type and is accessed by the Load and Store methods.
+	Atomic bool
+	// Track is the dirty fields tracking of setters.
+	Track *FieldTrack
+	// Const is the field constant that marks the field as dirty.
+	Const string
+	// Comparable means setters mark the field as dirty only if the new value differs from the old one.
+	Comparable bool
+}
+
+// FieldTrack is the receiver map field that contains the modified fields constants.
+type FieldTrack struct {
+	Field, MapType, KeyType, Mark string
+}
+
+// AtomicValueType returns the value type of the sync/atomic type.
+func AtomicValueType(typ types.Type) (types.Type, bool) {
+	named, _ := typ.(*types.Named)
+	if named == nil || named.Obj().Pkg() == nil || named.Obj().Pkg().Path() != "sync/atomic" {
+		return nil, false
+	} else if named.Obj().Name() == "Pointer" && named.TypeArgs().Len() == 1 {
+		return types.NewPointer(named.TypeArgs().At(0)), true
+	}
+	valueType, ok := atomicValueTypes[named.Obj().Name()]
+	return valueType, ok
+}
+
+func (a FieldAccess) conditions(conditions []string, receiverVar string, write bool) (string, string) {
+	start, end := split.AndReduce(conditions, string_.Wrap("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+	if len(a.Lock) == 0 || a.Atomic {
+		return start, end
+	}
+	lock, unlock := "Lock", "Unlock"
+	if a.RWLock && !write {
+		lock, unlock = "RLock", "RUnlock"
+	}
+	mutex := receiverVar + "." + a.Lock
+	locking := mutex + "." + lock + "()\n" + "defer " + mutex + "." + unlock + "()\n"
+	if len(conditions) == 0 {
+		return locking + start, end
+	}
+	// the first condition checks the receiver
+	first := "if " + conditions[0] + " {\n"
+	return first + locking + strings.TrimPrefix(start, first), end
+}
+
+func (a FieldAccess) read(field string) string {
+	return field + op.IfElse(a.Atomic, ".Load()", "")
+}
+
+func (a FieldAccess) write(receiverVar, field, value string) string {
+	if a.Atomic {
+		return field + ".Store(" + value + ")"
+	} else if a.Track == nil || len(a.Const) == 0 {
+		return field + "=" + value
+	} else if a.Comparable {
+		return "if " + field + " != " + value + " {\n" + field + "=" + value + "\n" + a.markDirty(receiverVar) + "}"
+	}
+	return field + "=" + value + "\n" + strings.TrimSuffix(a.markDirty(receiverVar), "\n")
+}
+
+func (a FieldAccess) markDirty(receiverVar string) string {
+	if a.Track == nil || len(a.Const) == 0 {
+		return ""
+	}
+	dirty := receiverVar + "." + a.Track.Field
+	return "if " + dirty + " == nil {\n" + dirty + " = make(" + a.Track.MapType + ")\n}\n" +
+		dirty + "[" + a.Track.KeyType + "(" + a.Const + ")] = " + a.Track.Mark + "\n"
+}
+
+func (a FieldAccess) reservedNames() []string {
+	if a.Track == nil || len(a.Const) == 0 {
+		return nil
+	}
+	return []string{a.Const}
+}
+
+func (g *Generator) GenerateSetter(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType string, nolint bool, isReceiverReference bool, fieldParts []FieldInfo, access FieldAccess) string {
 
 	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar), unique.DistinctBySuffix("_"))
 	params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
 	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
 	slice.ForEach(paramNames, uniqueNames.Add)
+	slice.ForEach(access.reservedNames(), uniqueNames.Add)
 
 	buildedType := GetTypeName(model.TypeName(), pkgName)
 	typeName := op.IfElse(isReceiverReference, "*", "") + buildedType
 	_, conditionalPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, isReceiverReference, false, fieldParts, uniqueNames)
-	varsConditionStart, varsConditionEnd := split.AndReduce(conditions, string_.Wrap("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+	varsConditionStart, varsConditionEnd := access.conditions(conditions, receiverVar, true)
 
 	arg := uniqueNames.Get(LegalIdentName(ArgName(fieldName)))
 	return get.If(len(pkgName) == 0,
 		sum.Of("func (", receiverVar, " ", typeName, typeParams, ") ", methodName, "(", arg, " ", fieldType, ")")).ElseGet(
 		sum.Of("func ", methodName, typeParamsDecl, "(", receiverVar, " ", typeName, typeParams, ",", arg, " ", fieldType, ")"),
 	) + " {" + NoLint(nolint) + "\n" + varsConditionStart +
-		op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar) + "." + fieldName + "=" + arg + "\n" +
+		access.write(receiverVar, op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar)+"."+fieldName, arg) + "\n" +
 		varsConditionEnd + "}\n"
 }
 
-func (g *Generator) GenerateGetter(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType string, nolint bool, isReceiverReference bool, fieldParts []FieldInfo) string {
+func (g *Generator) GenerateGetter(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType string, nolint bool, isReceiverReference bool, fieldParts []FieldInfo, access FieldAccess) string {
 	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar), unique.DistinctBySuffix("_"))
 	params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
 	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
@@ -44,7 +146,7 @@ func (g *Generator) GenerateGetter(model *struc.Model, pkgName, receiverVar, met
 	buildedType := GetTypeName(model.TypeName(), pkgName)
 	typeName := op.IfElse(isReceiverReference, "*", "") + buildedType
 	_, conditionalPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, isReceiverReference, false, fieldParts, uniqueNames)
-	varsConditionStart, varsConditionEnd := split.AndReduce(conditions, string_.Wrap("if ", " {\n"), replace.By("}\n"), op.Sum, op.Sum)
+	varsConditionStart, varsConditionEnd := access.conditions(conditions, receiverVar, false)
 
 	emptyVar := uniqueNames.Get("no")
 	emptyResult := "var " + emptyVar + " " + fieldType
@@ -53,6 +155,63 @@ func (g *Generator) GenerateGetter(model *struc.Model, pkgName, receiverVar, met
 		sum.Of("func (", receiverVar, " ", typeName, typeParams, ") ", methodName, "()")).ElseGet(
 		sum.Of("func ", methodName, typeParamsDecl, "(", receiverVar, " ", typeName, typeParams, ")"),
 	) + " " + fieldType + " {" + NoLint(nolint) + "\n" + varsConditionStart + "return " +
-		op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar) + "." + fieldName +
+		access.read(op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar)+"."+fieldName) +
 		varsConditionEnd + "\n" + get.If(len(varsConditionStart) > 0, sum.Of(emptyResult, "\n", "return ", emptyVar, "\n")).Else("") + "}\n"
 }
+
+// GenerateGetterOk generates a getter that returns the field value and whether all pointers on the field path are not nil.
+func (g *Generator) GenerateGetterOk(model *struc.Model, pkgName, receiverVar, methodName, fieldName, fieldType string, nolint bool, isReceiverReference bool, fieldParts []FieldInfo, access FieldAccess) string {
+	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar), unique.DistinctBySuffix("_"))
+	params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
+	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
+	slice.ForEach(paramNames, uniqueNames.Add)
+
+	buildedType := GetTypeName(model.TypeName(), pkgName)
+	typeName := op.IfElse(isReceiverReference, "*", "") + buildedType
+	_, conditionalPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, isReceiverReference, false, fieldParts, uniqueNames)
+	varsConditionStart, varsConditionEnd := access.conditions(conditions, receiverVar, false)
+
+	emptyVar := uniqueNames.Get("no")
+	emptyResult := "var " + emptyVar + " " + fieldType
+
+	return get.If(len(pkgName) == 0,
+		sum.Of("func (", receiverVar, " ", typeName, typeParams, ") ", methodName, "()")).ElseGet(
+		sum.Of("func ", methodName, typeParamsDecl, "(", receiverVar, " ", typeName, typeParams, ")"),
+	) + " (" + fieldType + ", bool) {" + NoLint(nolint) + "\n" + varsConditionStart + "return " +
+		access.read(op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar)+"."+fieldName) + ", true\n" +
+		varsConditionEnd + "\n" + get.If(len(varsConditionStart) > 0, sum.Of(emptyResult, "\n", "return ", emptyVar, ", false\n")).Else("") + "}\n"
+}
+
+// GenerateCollectionSetters generates the adding and removing methods of a slice or map field.
+func (g *Generator) GenerateCollectionSetters(
+	model *struc.Model, pkgName, receiverVar, fieldName string, fieldType types.Type, fullFieldType string,
+	exportMethods, nolint, isReceiverReference bool, fieldParts []FieldInfo, access FieldAccess,
+) ([]string, []string, error) {
+	uniqueNames := unique.NewNamesWith(unique.PreInit(receiverVar), unique.DistinctBySuffix("_"))
+	params := typeparams.New(model.Typ.TypeParams(), g.Repack, g.OutPkgPath)
+	typeParams, typeParamsDecl, paramNames := params.IdentDeclNamess()
+	slice.ForEach(paramNames, uniqueNames.Add)
+	slice.ForEach(access.reservedNames(), uniqueNames.Add)
+
+	buildedType := GetTypeName(model.TypeName(), pkgName)
+	typeName := op.IfElse(isReceiverReference, "*", "") + buildedType
+	_, conditionalPath, conditions := FiledPathAndAccessCheckCondition(receiverVar, isReceiverReference, false, fieldParts, uniqueNames)
+	varsConditionStart, varsConditionEnd := access.conditions(conditions, receiverVar, true)
+
+	methods, err := g.CollectionMethods(fieldType, fullFieldType, uniqueNames)
+	if err != nil {
+		return nil, nil, err
+	}
+	field := op.IfElse(len(varsConditionStart) > 0, conditionalPath, receiverVar) + "." + fieldName
+	names := make([]string, len(methods))
+	bodies := make([]string, len(methods))
+	for i, method := range methods {
+		methodName := IdentName(method.Prefix+LegalIdentName(IdentName(fieldName, true)), exportMethods)
+		names[i] = methodName
+		bodies[i] = get.If(len(pkgName) == 0,
+			sum.Of("func (", receiverVar, " ", typeName, typeParams, ") ", methodName, "(", method.Params, ")")).ElseGet(
+			sum.Of("func ", methodName, typeParamsDecl, "(", receiverVar, " ", typeName, typeParams, ",", method.Params, ")"),
+		) + " {" + NoLint(nolint) + "\n" + varsConditionStart + method.Body(field) + access.markDirty(receiverVar) + varsConditionEnd + "}\n"
+	}
+	return names, bodies, nil
+}
@@ -2,12 +2,17 @@ package generator
 
 import (
 	"fmt"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/m4gshm/fieldr/logger"
 	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
 )
 
 type RewriteTrigger string
@@ -16,73 +21,85 @@ const (
 	RewriteTriggerEmpty RewriteTrigger = ""
 	RewriteTriggerField RewriteTrigger = "field"
 	RewriteTriggerType  RewriteTrigger = "type"
+	RewriteTriggerTag   RewriteTrigger = "tag"
+	RewriteTriggerKind  RewriteTrigger = "kind"
 )
 
 type RewriteEngine string
 
 const (
-	RewriteEngineFmt RewriteEngine = "fmt"
+	RewriteEngineFmt    RewriteEngine = "fmt"
+	RewriteEngineExpr   RewriteEngine = "expr"
+	RewriteEngineCall   RewriteEngine = "call"
+	RewriteEngineMethod RewriteEngine = "method"
 )
 
+// RewriteValuePlaceholder is the field value placeholder of the expr rewrite engine.
+const RewriteValuePlaceholder = "$v"
+
+// rewriteFunc rewrites the field value expression.
+type rewriteFunc func(ctx rewriteContext, fieldValue string) (string, error)
+
+// rewriteContext is the rewritten field and the struct that declares the field.
+type rewriteContext struct {
+	g     *Generator
+	model *struc.Model
+	field FieldInfo
+}
+
+type tagRewriters struct {
+	tag       struc.TagName
+	rewriters []rewriteFunc
+}
+
 type CodeRewriter struct {
-	byFieldName map[struc.FieldName][]func(string) string
-	byFieldType map[string][]func(string) string
-	all         []func(string) string
+	byFieldName map[struc.FieldName][]rewriteFunc
+	byFieldType map[string][]rewriteFunc
+	byTag       []tagRewriters
+	byKind      map[string][]rewriteFunc
+	all         []rewriteFunc
 }
 
 func NewCodeRewriter(fieldValueRewriters []string) (*CodeRewriter, error) {
 	r := &CodeRewriter{
-		byFieldName: map[string][]func(string) string{},
-		byFieldType: map[string][]func(string) string{},
-		all:         []func(string) string{},
+		byFieldName: map[string][]rewriteFunc{},
+		byFieldType: map[string][]rewriteFunc{},
+		byKind:      map[string][]rewriteFunc{},
+		all:         []rewriteFunc{},
 	}
 	for _, rewList := range fieldValueRewriters {
-		rewritersCfg := strings.Split(rewList, struc.ListValuesSeparator)
+		rewritersCfg := splitTopLevel(rewList, struc.ListValuesSeparator)
 		for _, rewriterCfg := range rewritersCfg {
+			// the engine data follows the first '=' and may contain any separators
+			engineDataStart := strings.Index(rewriterCfg, struc.ReplaceableValueSeparator)
+			if engineDataStart < 0 {
+				return nil, errors.Errorf("Undefined rewriter value '%v'", rewriterCfg)
+			}
 			var (
-				rewParts        = strings.Split(rewriterCfg, struc.KeyValueSeparator)
+				rewEngineData   = rewriterCfg[engineDataStart+1:]
+				rewParts        = strings.Split(rewriterCfg[:engineDataStart], struc.KeyValueSeparator)
 				rewTrigger      RewriteTrigger
 				rewTriggerValue string
-				rewEngingCfg    string
+				rewEngine       RewriteEngine
 			)
 			if len(rewParts) == 1 {
 				rewTrigger = RewriteTriggerEmpty
-				rewTriggerValue = rewParts[0]
-				rewEngingCfg = rewTriggerValue
+				rewEngine = RewriteEngine(rewParts[0])
 			} else if len(rewParts) == 2 {
 				rewTrigger = RewriteTriggerField
 				rewTriggerValue = rewParts[0]
-				rewEngingCfg = rewParts[1]
+				rewEngine = RewriteEngine(rewParts[1])
 			} else if len(rewParts) == 3 {
 				rewTrigger = RewriteTrigger(rewParts[0])
 				rewTriggerValue = rewParts[1]
-				rewEngingCfg = rewParts[2]
+				rewEngine = RewriteEngine(rewParts[2])
 			} else {
 				return nil, errors.Errorf("Unsupported transformValue format '%v'", rewriterCfg)
 			}
 
-			var (
-				rewEngineParts = strings.Split(rewEngingCfg, struc.ReplaceableValueSeparator)
-				rewEngine      RewriteEngine
-				rewEngineData  string
-			)
-			if len(rewEngineParts) == 0 {
-				return nil, errors.Errorf("Undefined rewriter value '%v'", rewriterCfg)
-			} else if len(rewEngineParts) == 2 {
-				rewEngine = RewriteEngine(rewEngineParts[0])
-				rewEngineData = rewEngineParts[1]
-			} else {
-				return nil, errors.Errorf("Unsupported rewriter value '%v' from '%v'", rewEngineParts[0], rewEngingCfg)
-			}
-
-			var rewFunc func(string) string
-			switch rewEngine {
-			case RewriteEngineFmt:
-				rewFunc = func(fieldValue string) string {
-					return fmt.Sprintf(rewEngineData, fieldValue)
-				}
-			default:
-				return nil, errors.Errorf("Unsupported transform engine '%v' from '%v'", rewEngine, rewriterCfg)
+			rewFunc, err := newRewriteFunc(rewEngine, rewEngineData)
+			if err != nil {
+				return nil, errors.Errorf("%v from '%v'", err, rewriterCfg)
 			}
 
 			switch rewTrigger {
@@ -92,6 +109,10 @@ func NewCodeRewriter(fieldValueRewriters []string) (*CodeRewriter, error) {
 				r.byFieldName[rewTriggerValue] = append(r.byFieldName[rewTriggerValue], rewFunc)
 			case RewriteTriggerType:
 				r.byFieldType[rewTriggerValue] = append(r.byFieldType[rewTriggerValue], rewFunc)
+			case RewriteTriggerTag:
+				r.addByTag(rewTriggerValue, rewFunc)
+			case RewriteTriggerKind:
+				r.byKind[rewTriggerValue] = append(r.byKind[rewTriggerValue], rewFunc)
 			default:
 				return nil, errors.Errorf("Unsupported transform trigger '%v' from '%v'", rewTrigger, rewriterCfg)
 			}
@@ -100,24 +121,284 @@ func NewCodeRewriter(fieldValueRewriters []string) (*CodeRewriter, error) {
 	return r, nil
 }
 
-func (rewrite *CodeRewriter) Transform(fieldName string, filedType string, fieldRef string) (string, bool) {
+func (rewrite *CodeRewriter) addByTag(tag struc.TagName, rewFunc rewriteFunc) {
+	for i := range rewrite.byTag {
+		if rewrite.byTag[i].tag == tag {
+			rewrite.byTag[i].rewriters = append(rewrite.byTag[i].rewriters, rewFunc)
+			return
+		}
+	}
+	rewrite.byTag = append(rewrite.byTag, tagRewriters{tag: tag, rewriters: []rewriteFunc{rewFunc}})
+}
+
+func newRewriteFunc(engine RewriteEngine, data string) (rewriteFunc, error) {
+	switch engine {
+	case RewriteEngineFmt:
+		return func(_ rewriteContext, fieldValue string) (string, error) {
+			return fmt.Sprintf(data, fieldValue), nil
+		}, nil
+	case RewriteEngineExpr:
+		if !strings.Contains(data, RewriteValuePlaceholder) {
+			return nil, errors.Errorf("expression '%v' has no field value placeholder %s", data, RewriteValuePlaceholder)
+		}
+		return func(ctx rewriteContext, fieldValue string) (string, error) {
+			return ctx.rewriteExpr(data, fieldValue)
+		}, nil
+	case RewriteEngineCall:
+		if len(data) == 0 {
+			return nil, errors.New("empty function name")
+		}
+		return func(ctx rewriteContext, fieldValue string) (string, error) {
+			if err := ctx.checkCall(data); err != nil {
+				return "", err
+			}
+			funcName, err := ctx.g.importedFuncName(data)
+			if err != nil {
+				return "", err
+			}
+			return funcName + "(" + fieldValue + ")", nil
+		}, nil
+	case RewriteEngineMethod:
+		if !token.IsIdentifier(data) {
+			return nil, errors.Errorf("invalid method name '%v'", data)
+		}
+		return func(ctx rewriteContext, fieldValue string) (string, error) {
+			if err := ctx.checkExpr(RewriteValuePlaceholder + "." + data + "()"); err != nil {
+				return "", err
+			}
+			return operand(fieldValue) + "." + data + "()", nil
+		}, nil
+	default:
+		return nil, errors.Errorf("Unsupported transform engine '%v'", engine)
+	}
+}
+
+// Transform applies the rewriters of the field to the field value expression.
+// The rewriters are selected by the field name, the field type, a tag of the field, the field type kind or all fields rewriters in that order.
+func (rewrite *CodeRewriter) Transform(g *Generator, model *struc.Model, fieldPath []FieldInfo, fieldRef string) (string, bool, error) {
+	field := fieldPath[len(fieldPath)-1]
+	fieldName, filedType := field.Name, field.Type.FullName(model.OutPkgPath)
+	owner := model
+	for _, parent := range fieldPath[:len(fieldPath)-1] {
+		owner = parent.Type.Model
+	}
 	rewriters, ok := rewrite.byFieldName[fieldName]
 	if !ok {
-		
-		if rewriters, ok = rewrite.byFieldType[filedType]; !ok {
-			logger.Debugf("no rewriter by type for field %s, type %s", fieldName, filedType)
-			rewriters = rewrite.all[:]
+		rewriters, ok = rewrite.byFieldType[filedType]
+	}
+	if !ok {
+		for _, byTag := range rewrite.byTag {
+			if _, ok = owner.FieldsTagValue[fieldName][byTag.tag]; ok {
+				rewriters = byTag.rewriters
+				break
+			}
 		}
 	}
+	if !ok {
+		rewriters, ok = rewrite.byKind[typeKind(field.Type.Type)]
+	}
+	if !ok {
+		logger.Debugf("no rewriter by type for field %s, type %s", fieldName, filedType)
+		rewriters = rewrite.all[:]
+	}
 	if len(rewriters) == 0 {
-		return fieldRef, false
+		return fieldRef, false, nil
 	}
+	ctx := rewriteContext{g: g, model: owner, field: field}
 	rewrited := false
 	for _, rewrite := range rewriters {
 		before := fieldRef
-		fieldRef = rewrite(fieldRef)
+		var err error
+		if fieldRef, err = rewrite(ctx, fieldRef); err != nil {
+			return "", false, fmt.Errorf("rewrite field %s: %w", fieldName, err)
+		}
 		logger.Debugf("transforming field value: field %s, value before %s, after %s", fieldName, before, fieldRef)
 		rewrited = rewrited || before != fieldRef
 	}
-	return fieldRef, rewrited
+	return fieldRef, rewrited, nil
+}
+
+// rewriteExpr replaces the placeholder of the expression by the field value.
+// The expression is type checked in the scope of the struct file with a value of the field type in place of the placeholder,
+// so it can use the packages imported by the struct file.
+func (ctx rewriteContext) rewriteExpr(expression, fieldValue string) (string, error) {
+	if err := ctx.checkExpr(expression); err != nil {
+		return "", err
+	}
+	rewritten, err := ctx.g.importUsedPackages(ctx.model.Package(), ctx.model.Typ.Obj().Pos(), strings.ReplaceAll(expression, RewriteValuePlaceholder, operand(fieldValue)))
+	if err != nil {
+		return "", fmt.Errorf("invalid expression '%s': %w", expression, err)
+	}
+	return rewritten, nil
+}
+
+// checkExpr type checks the expression in the scope of the struct file with a value of the field type in place of the placeholder.
+// The check is skipped for generic field types and for packages loaded without syntax.
+func (ctx rewriteContext) checkExpr(expression string) error {
+	typ := ctx.field.Type.Type
+	pkg := ctx.model.Package()
+	if pkg == nil || pkg.Scope().NumChildren() == 0 || hasTypeParams(typ) {
+		return nil
+	}
+	typed := "(*(*" + util.TypeString(typ, pkg.Path()) + ")(nil))"
+	if node, err := parser.ParseExpr(strings.ReplaceAll(expression, RewriteValuePlaceholder, typed)); err != nil {
+		return fmt.Errorf("invalid expression '%s': %w", expression, err)
+	} else if err := types.CheckExpr(ctx.g.fileSet, pkg, ctx.model.Typ.Obj().Pos(), node, nil); err != nil {
+		return fmt.Errorf("expression '%s' of type %s: %w", expression, util.TypeString(typ, ctx.g.OutPkgPath), checkError(err))
+	}
+	return nil
+}
+
+// checkCall checks that the function like 'strconv.Quote' or 'example.com/pkg.Func' accepts a value of the field type.
+// A package unreachable from the struct package is loaded separately, its types cannot be compared with the types of the struct package,
+// so the function is checked for existence only unless the field type is basic.
+func (ctx rewriteContext) checkCall(funcRef string) error {
+	structPkg := ctx.model.Package()
+	if structPkg == nil {
+		return nil
+	}
+	pkgPath, funcName := ctx.g.OutPkgPath, funcRef
+	if dot := strings.LastIndex(funcRef, "."); dot >= 0 {
+		pkgPath, funcName = funcRef[:dot], funcRef[dot+1:]
+	}
+	if pkgPath == structPkg.Path() {
+		return ctx.checkExpr(funcName + "(" + RewriteValuePlaceholder + ")")
+	} else if pkgPath == ctx.g.OutPkgPath {
+		// the function of the output package may be declared by the generated code
+		return nil
+	}
+	typ := ctx.field.Type.Type
+	pkg := findImported(structPkg, pkgPath, map[string]bool{})
+	identical := pkg != nil
+	if pkg == nil {
+		dir := filepath.Dir(ctx.g.fileSet.Position(ctx.model.Typ.Obj().Pos()).Filename)
+		loaded, err := util.LoadPackageTypes(ctx.g.fileSet, ctx.g.buildTags, dir, pkgPath)
+		if err != nil {
+			return fmt.Errorf("function '%s': %w", funcRef, err)
+		}
+		pkg = loaded
+		_, identical = typ.(*types.Basic)
+	}
+	if identical && !hasTypeParams(typ) {
+		scope := types.NewPackage("fieldr/rewrite", "rewrite")
+		scope.Scope().Insert(types.NewPkgName(token.NoPos, scope, pkg.Name(), pkg))
+		scope.Scope().Insert(types.NewVar(token.NoPos, scope, "v", typ))
+		if node, err := parser.ParseExpr(pkg.Name() + "." + funcName + "(v)"); err != nil {
+			return fmt.Errorf("invalid function '%s': %w", funcRef, err)
+		} else if err := types.CheckExpr(token.NewFileSet(), scope, token.NoPos, node, nil); err != nil {
+			return fmt.Errorf("function '%s' of type %s: %w", funcRef, util.TypeString(typ, ctx.g.OutPkgPath), checkError(err))
+		}
+		return nil
+	}
+	fn, ok := pkg.Scope().Lookup(funcName).(*types.Func)
+	if !ok || !fn.Exported() {
+		return fmt.Errorf("function '%s' is not found", funcRef)
+	}
+	sig := fn.Type().(*types.Signature)
+	if params := sig.Params().Len(); params != 1 && !(sig.Variadic() && params == 2) {
+		return fmt.Errorf("function '%s' cannot be called with one argument", funcRef)
+	}
+	return nil
+}
+
+// checkError strips the position of a type checking error, it points to the parsed expression rather than to a source file.
+func checkError(err error) error {
+	if typeErr, ok := err.(types.Error); ok {
+		return errors.New(typeErr.Msg)
+	}
+	return err
+}
+
+// findImported looks for the package in the imports graph of the package.
+func findImported(pkg *types.Package, pkgPath string, visited map[string]bool) *types.Package {
+	if pkg == nil || visited[pkg.Path()] {
+		return nil
+	} else if pkg.Path() == pkgPath {
+		return pkg
+	}
+	visited[pkg.Path()] = true
+	for _, imp := range pkg.Imports() {
+		if found := findImported(imp, pkgPath, visited); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// importedFuncName imports the package of the function like 'strconv.Quote' or 'example.com/pkg.Func' and returns the qualified function name.
+func (g *Generator) importedFuncName(funcRef string) (string, error) {
+	dot := strings.LastIndex(funcRef, ".")
+	if dot < 0 {
+		return funcRef, nil
+	}
+	pkgPath, funcName := funcRef[:dot], funcRef[dot+1:]
+	if pkgPath == g.OutPkgPath {
+		return funcName, nil
+	}
+	alias, err := g.AddImport(pkgPath, "", nil)
+	if err != nil {
+		return "", err
+	}
+	if len(alias) == 0 {
+		alias = util.GetPackageName(pkgPath)
+	}
+	return alias + "." + funcName, nil
+}
+
+func hasTypeParams(typ types.Type) bool {
+	switch t := typ.(type) {
+	case *types.TypeParam:
+		return true
+	case *types.Named:
+		return t.TypeArgs().Len() > 0
+	case *types.Pointer:
+		return hasTypeParams(t.Elem())
+	case *types.Slice:
+		return hasTypeParams(t.Elem())
+	case *types.Array:
+		return hasTypeParams(t.Elem())
+	case *types.Map:
+		return hasTypeParams(t.Key()) || hasTypeParams(t.Elem())
+	case *types.Chan:
+		return hasTypeParams(t.Elem())
+	}
+	return false
+}
+
+// operand wraps the value expression in parentheses if it is not a selector or an identifier.
+func operand(value string) string {
+	for _, part := range strings.Split(value, ".") {
+		if !token.IsIdentifier(part) {
+			return "(" + value + ")"
+		}
+	}
+	return value
+}
+
+// splitTopLevel splits the value by the separator outside of brackets and quotes.
+func splitTopLevel(value, sep string) []string {
+	var (
+		parts []string
+		depth int
+		quote rune
+		start int
+	)
+	for i, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote && (i == 0 || value[i-1] != '\\') {
+				quote = 0
+			}
+		case r == '"' || r == '\'' || r == '`':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			depth++
+		case r == ')' || r == ']' || r == '}':
+			depth--
+		case depth == 0 && strings.HasPrefix(value[i:], sep):
+			parts = append(parts, value[start:i])
+			start = i + len(sep)
+		}
+	}
+	return append(parts, value[start:])
 }
@@ -0,0 +1,34 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_splitTopLevel(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, splitTopLevel("a,b", ","))
+	assert.Equal(t, []string{"expr=f($v, 1)", "call=g"}, splitTopLevel("expr=f($v, 1),call=g", ","))
+	assert.Equal(t, []string{`fmt=%v+","`}, splitTopLevel(`fmt=%v+","`, ","))
+}
+
+func Test_NewCodeRewriter(t *testing.T) {
+	r, err := NewCodeRewriter([]string{"tag:secret:call=mask,kind:slice:expr=len($v)", "Name:method=String"})
+	require.NoError(t, err)
+	assert.Len(t, r.byTag, 1)
+	assert.Len(t, r.byKind["slice"], 1)
+	assert.Len(t, r.byFieldName["Name"], 1)
+
+	_, err = NewCodeRewriter([]string{"expr=len(v)"})
+	assert.Error(t, err)
+	_, err = NewCodeRewriter([]string{"method=Str()"})
+	assert.Error(t, err)
+	_, err = NewCodeRewriter([]string{"unknown:x:fmt=%v"})
+	assert.Error(t, err)
+}
+
+func Test_operand(t *testing.T) {
+	assert.Equal(t, "s.Field", operand("s.Field"))
+	assert.Equal(t, "(*s.Field)", operand("*s.Field"))
+}
@@ -0,0 +1,134 @@
+package generator
+
+import (
+	"cmp"
+	"fmt"
+	goconstant "go/constant"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/expr-lang/expr"
+	"github.com/m4gshm/gollections/op"
+
+	"github.com/m4gshm/fieldr/model/struc"
+	"github.com/m4gshm/fieldr/model/util"
+)
+
+// sortByExpr stably sorts the elements by the keys that the expression returns for the element environments.
+func sortByExpr[T any](elements []T, sortExpr string, env func(T) (map[string]any, []expr.Option)) error {
+	if len(sortExpr) == 0 {
+		return nil
+	}
+	keys := make(map[int]any, len(elements))
+	indexes := make([]int, len(elements))
+	for i, element := range elements {
+		elementEnv, opts := env(element)
+		program, err := expr.Compile(sortExpr, append([]expr.Option{expr.Env(elementEnv)}, opts...)...)
+		if err != nil {
+			return fmt.Errorf("compile sort expression %s: %w", sortExpr, err)
+		}
+		key, err := expr.Run(program, elementEnv)
+		if err != nil {
+			return fmt.Errorf("run sort expression %s: %w", sortExpr, err)
+		}
+		keys[i], indexes[i] = key, i
+	}
+	slices.SortStableFunc(indexes, func(l, r int) int { return compareSortKeys(keys[l], keys[r]) })
+	sorted := make([]T, len(elements))
+	for i, index := range indexes {
+		sorted[i] = elements[index]
+	}
+	copy(elements, sorted)
+	return nil
+}
+
+// compareSortKeys compares numbers by value, booleans as false < true, lists element by element and other values as strings.
+// Nil keys are less than others.
+func compareSortKeys(l, r any) int {
+	if isNil(l) || isNil(r) {
+		return cmp.Compare(boolOrder(!isNil(l)), boolOrder(!isNil(r)))
+	}
+	lv, rv := reflect.ValueOf(l), reflect.ValueOf(r)
+	if lf, ok := sortNumber(lv); ok {
+		if rf, ok := sortNumber(rv); ok {
+			return cmp.Compare(lf, rf)
+		}
+	}
+	if lb, ok := l.(bool); ok {
+		if rb, ok := r.(bool); ok {
+			return cmp.Compare(boolOrder(lb), boolOrder(rb))
+		}
+	}
+	if isList(lv) && isList(rv) {
+		for i := 0; i < lv.Len() && i < rv.Len(); i++ {
+			if c := compareSortKeys(lv.Index(i).Interface(), rv.Index(i).Interface()); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(lv.Len(), rv.Len())
+	}
+	return strings.Compare(toString(l), toString(r))
+}
+
+func sortNumber(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+func boolOrder(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// SortFieldConstants stably sorts the constants by the keys of the sort expression evaluated with the fields-to-consts expression
+// environment of the constant field and the 'value' and 'const' properties, the constant value and name.
+// The field.fullPath property is joined by the value separator of the flat paths like in the value expression.
+func (g *Generator) SortFieldConstants(structModel *struc.Model, constants []FieldConst, sortExpr string, flatPaths FlatPaths) error {
+	return sortByExpr(constants, sortExpr, func(constant FieldConst) (map[string]any, []expr.Option) {
+		model, path := structModel, ""
+		parents, field := constant.fieldPath[:len(constant.fieldPath)-1], constant.fieldPath[len(constant.fieldPath)-1]
+		for _, parent := range parents {
+			model, path = parent.Type.Model, path+op.IfElse(len(path) > 0, ".", "")+parent.Name
+		}
+		tags := map[string]*tagValue{}
+		for tag, val := range model.FieldsTagValue[field.Name] {
+			tags[tag] = newTagValue(val, func() {})
+		}
+		return addCommonFuncs(map[string]any{
+			"struct": structEnv(structModel, g.OutPkgPath),
+			"name":   field.Name,
+			"field":  fieldEnv(model, g.OutPkgPath, path, flatPaths.fullPath(path, field.Name), field.Name, field.Type),
+			"tag":    tags,
+			"value":  constant.value,
+			"const":  constant.name,
+		}), []expr.Option{tagValueGetter(tags)}
+	})
+}
+
+// SortEnumValues stably sorts the values of the constants of the type by the keys of the sort expression evaluated with
+// the 'name' (the first constant name of the value), 'names' (all constant names of the value), 'value' and 'type' properties.
+func SortEnumValues(typ util.TypeNamedOrAlias, values []goconstant.Value, valNames map[goconstant.Value][]string, sortExpr string) error {
+	return sortByExpr(values, sortExpr, func(value goconstant.Value) (map[string]any, []expr.Option) {
+		names := valNames[value]
+		return addCommonFuncs(map[string]any{
+			"name":  names[0],
+			"names": names,
+			"value": goconstant.Val(value),
+			"type":  typ.Obj().Name(),
+		}), nil
+	})
+}
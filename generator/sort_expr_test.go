@@ -0,0 +1,32 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/expr-lang/expr"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_compareSortKeys(t *testing.T) {
+	assert.Equal(t, -1, compareSortKeys(2, 10))
+	assert.Equal(t, 1, compareSortKeys(2.5, int64(2)))
+	assert.Equal(t, -1, compareSortKeys("10", "2"))
+	assert.Equal(t, -1, compareSortKeys(false, true))
+	assert.Equal(t, -1, compareSortKeys(nil, 0))
+	assert.Equal(t, 0, compareSortKeys(nil, nil))
+	assert.Equal(t, -1, compareSortKeys([]any{1, "b"}, []any{1, "c"}))
+	assert.Equal(t, 1, compareSortKeys([]any{1, "b"}, []any{1}))
+}
+
+func Test_sortByExpr(t *testing.T) {
+	elements := []string{"c", "bb", "a", "dd"}
+	err := sortByExpr(elements, "[-len(name), name]", func(name string) (map[string]any, []expr.Option) {
+		return map[string]any{"name": name}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bb", "dd", "a", "c"}, elements)
+
+	assert.Error(t, sortByExpr(elements, "name +", func(name string) (map[string]any, []expr.Option) {
+		return map[string]any{"name": name}, nil
+	}))
+}
@@ -0,0 +1,8 @@
+package conv
+
+import "strings"
+
+// Norm trims and lowercases the value.
+func Norm(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
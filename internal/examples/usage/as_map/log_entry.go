@@ -0,0 +1,37 @@
+package as_map
+
+import (
+	str "strings"
+	"time"
+)
+
+//go:generate fieldr -type LogEntry -out log_entry_fieldr.go as-map -key-type . -rewrite type:time.Time:expr=${DOLLAR}v.Format(time.RFC3339) -rewrite type:Level:method=String -rewrite field:Message:expr=str.TrimSpace(${DOLLAR}v) -rewrite tag:secret:call=mask -rewrite kind:slice:call=slices.Clone
+
+type Level int
+
+const (
+	Info Level = iota
+	Error
+)
+
+func (l Level) String() string {
+	if l == Error {
+		return "error"
+	}
+	return "info"
+}
+
+type LogEntry struct {
+	Created time.Time
+	Level   Level
+	Message string
+	Token   string `secret:""`
+	Labels  []string
+}
+
+func mask(value string) string {
+	if len(str.TrimSpace(value)) == 0 {
+		return ""
+	}
+	return "***"
+}
@@ -0,0 +1,32 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package as_map
+
+import (
+	"slices"
+	str "strings"
+	"time"
+)
+
+type logEntryField string
+
+const (
+	created logEntryField = "Created"
+	level   logEntryField = "Level"
+	message logEntryField = "Message"
+	token   logEntryField = "Token"
+	labels  logEntryField = "Labels"
+)
+
+func (l *LogEntry) asMap() map[logEntryField]any {
+	if l == nil {
+		return nil
+	}
+	m := map[logEntryField]any{}
+	m[created] = l.Created.Format(time.RFC3339)
+	m[level] = l.Level.String()
+	m[message] = str.TrimSpace(l.Message)
+	m[token] = mask(l.Token)
+	m[labels] = slices.Clone(l.Labels)
+	return m
+}
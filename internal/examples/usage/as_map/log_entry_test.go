@@ -0,0 +1,30 @@
+package as_map
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LogEntryRewrite(t *testing.T) {
+	source := []string{"a"}
+	entry := &LogEntry{
+		Created: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Level:   Error,
+		Message: " message ",
+		Token:   "token",
+		Labels:  source,
+	}
+	m := entry.asMap()
+	assert.Equal(t, map[logEntryField]any{
+		created: "2024-01-02T03:04:05Z",
+		level:   "error",
+		message: "message",
+		token:   "***",
+		labels:  []string{"a"},
+	}, m)
+
+	source[0] = "b"
+	assert.Equal(t, []string{"a"}, m[labels])
+}
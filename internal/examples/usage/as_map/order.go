@@ -0,0 +1,42 @@
+package as_map
+
+import "time"
+
+//go:generate fieldr -type Order -out order_fieldr.go as-map -nested
+
+type Address struct {
+	City   string
+	Street string
+}
+
+type Audit struct {
+	CreatedBy string
+	Version   int
+}
+
+type Customer struct {
+	*Audit
+	Name    string
+	Address Address
+	email   string
+}
+
+type Line struct {
+	Audit
+	Product  string
+	Quantity int
+}
+
+type Note struct {
+	Text string
+}
+
+type Order struct {
+	ID       int
+	Customer Customer
+	Shipping *Address
+	Lines    []Line
+	Notes    []*Note
+	Parent   *Order
+	PlacedAt time.Time
+}
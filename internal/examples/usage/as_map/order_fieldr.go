@@ -0,0 +1,144 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package as_map
+
+const (
+	id       = "ID"
+	customer = "Customer"
+	shipping = "Shipping"
+	lines    = "Lines"
+	notes    = "Notes"
+	parent   = "Parent"
+	placedAt = "PlacedAt"
+)
+
+func asMapAddress(v *Address) map[string]any {
+	if v == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m["City"] = v.City
+	m["Street"] = v.Street
+	return m
+}
+
+func asMapCustomer(v *Customer) map[string]any {
+	if v == nil {
+		return nil
+	}
+	m := map[string]any{}
+	if a := v.Audit; a != nil {
+		m["CreatedBy"] = a.CreatedBy
+	}
+	if a := v.Audit; a != nil {
+		m["Version"] = a.Version
+	}
+	m["Name"] = v.Name
+	m["Address"] = asMapAddress(&v.Address)
+	return m
+}
+
+func asMapLine(v *Line) map[string]any {
+	if v == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m["CreatedBy"] = v.Audit.CreatedBy
+	m["Version"] = v.Audit.Version
+	m["Product"] = v.Product
+	m["Quantity"] = v.Quantity
+	return m
+}
+
+func asMapLineSlice(values []Line) []map[string]any {
+	if values == nil {
+		return nil
+	}
+	maps := make([]map[string]any, len(values))
+	for i := range values {
+		maps[i] = asMapLine(&values[i])
+	}
+	return maps
+}
+
+func asMapNote(v *Note) map[string]any {
+	if v == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m["Text"] = v.Text
+	return m
+}
+
+func asMapNoteRefSlice(values []*Note) []map[string]any {
+	if values == nil {
+		return nil
+	}
+	maps := make([]map[string]any, len(values))
+	for i := range values {
+		maps[i] = asMapNote(values[i])
+	}
+	return maps
+}
+
+func asMapOrder(v *Order) map[string]any {
+	if v == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m["ID"] = v.ID
+	m["Customer"] = asMapCustomer(&v.Customer)
+	if v.Shipping != nil {
+		m["Shipping"] = asMapAddress(v.Shipping)
+	} else {
+		m["Shipping"] = nil
+	}
+	if v.Lines != nil {
+		m["Lines"] = asMapLineSlice(v.Lines)
+	} else {
+		m["Lines"] = nil
+	}
+	if v.Notes != nil {
+		m["Notes"] = asMapNoteRefSlice(v.Notes)
+	} else {
+		m["Notes"] = nil
+	}
+	if v.Parent != nil {
+		m["Parent"] = asMapOrder(v.Parent)
+	} else {
+		m["Parent"] = nil
+	}
+	m["PlacedAt"] = v.PlacedAt
+	return m
+}
+
+func (o *Order) asMap() map[string]any {
+	if o == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m[id] = o.ID
+	m[customer] = asMapCustomer(&o.Customer)
+	if o.Shipping != nil {
+		m[shipping] = asMapAddress(o.Shipping)
+	} else {
+		m[shipping] = nil
+	}
+	if o.Lines != nil {
+		m[lines] = asMapLineSlice(o.Lines)
+	} else {
+		m[lines] = nil
+	}
+	if o.Notes != nil {
+		m[notes] = asMapNoteRefSlice(o.Notes)
+	} else {
+		m[notes] = nil
+	}
+	if o.Parent != nil {
+		m[parent] = asMapOrder(o.Parent)
+	} else {
+		m[parent] = nil
+	}
+	m[placedAt] = o.PlacedAt
+	return m
+}
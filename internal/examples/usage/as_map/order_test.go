@@ -0,0 +1,55 @@
+package as_map
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderNestedMap(t *testing.T) {
+	placed := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	previous := &Order{ID: 1, PlacedAt: placed}
+	order := &Order{
+		ID:       2,
+		Customer: Customer{Audit: &Audit{CreatedBy: "admin", Version: 3}, Name: "Bob", Address: Address{City: "Paris"}, email: "bob@example.com"},
+		Shipping: &Address{City: "Lyon", Street: "Rue"},
+		Lines:    []Line{{Product: "pen", Quantity: 2}},
+		Notes:    []*Note{{Text: "fragile"}, nil},
+		Parent:   previous,
+		PlacedAt: placed,
+	}
+
+	assert.Equal(t, map[string]any{
+		id: 2,
+		customer: map[string]any{
+			"CreatedBy": "admin",
+			"Version":   3,
+			"Name":      "Bob",
+			"Address":   map[string]any{"City": "Paris", "Street": ""},
+		},
+		shipping: map[string]any{"City": "Lyon", "Street": "Rue"},
+		lines:    []map[string]any{{"CreatedBy": "", "Version": 0, "Product": "pen", "Quantity": 2}},
+		notes:    []map[string]any{{"Text": "fragile"}, nil},
+		parent: map[string]any{
+			"ID":       1,
+			"Customer": map[string]any{"Name": "", "Address": map[string]any{"City": "", "Street": ""}},
+			"Shipping": nil,
+			"Lines":    nil,
+			"Notes":    nil,
+			"Parent":   nil,
+			"PlacedAt": placed,
+		},
+		placedAt: placed,
+	}, order.asMap())
+}
+
+func Test_OrderNestedMapNil(t *testing.T) {
+	var order *Order
+	assert.Nil(t, order.asMap())
+
+	m := (&Order{}).asMap()
+	assert.Nil(t, m[shipping])
+	assert.Nil(t, m[lines])
+	assert.Nil(t, m[parent])
+}
@@ -0,0 +1,10 @@
+package as_map
+
+//go:generate fieldr -type Stats -out stats_fieldr.go as-map -ref -rewrite Count:call=strconv.Itoa -rewrite kind:slice:expr=len(${DOLLAR}v) -rewrite Label:call=example/usage/as_map/conv.Norm
+
+type Stats struct {
+	Count int
+	Tags  []string
+	Name  string
+	Label string
+}
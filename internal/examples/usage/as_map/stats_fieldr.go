@@ -0,0 +1,27 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package as_map
+
+import (
+	"example/usage/as_map/conv"
+	"strconv"
+)
+
+const (
+	count = "Count"
+	tags  = "Tags"
+	name  = "Name"
+	label = "Label"
+)
+
+func (s *Stats) asMap() map[string]any {
+	if s == nil {
+		return nil
+	}
+	m := map[string]any{}
+	m[count] = strconv.Itoa(s.Count)
+	m[tags] = len(s.Tags)
+	m[name] = &s.Name
+	m[label] = conv.Norm(s.Label)
+	return m
+}
@@ -0,0 +1,19 @@
+package as_map
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StatsRefRewrite(t *testing.T) {
+	stats := &Stats{Count: 3, Tags: []string{"a", "b"}, Name: "stats", Label: " Daily "}
+	m := stats.asMap()
+	assert.Equal(t, map[string]any{
+		count: "3",
+		tags:  2,
+		name:  &stats.Name,
+		label: "daily",
+	}, m)
+	assert.Same(t, &stats.Name, m[name])
+}
@@ -0,0 +1,9 @@
+package builder
+
+//go:generate fieldr -type Account builder -required Login -deconstructor .
+type Account[ID any] struct {
+	*Model[ID]
+	Login string
+	Email string `builder:"required"`
+	Name  string
+}
@@ -0,0 +1,117 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package builder
+
+import (
+	"errors"
+	"strings"
+)
+
+type AccountBuilder[ID any] struct {
+	id        ID
+	createdAt int64
+	updatedAt int64
+	login     string
+	loginSet  bool
+	email     string
+	emailSet  bool
+	name      string
+}
+
+func NewAccountBuilder[ID any]() *AccountBuilder[ID] {
+	return &AccountBuilder[ID]{}
+}
+
+func (b *AccountBuilder[ID]) Build() (*Account[ID], error) {
+	var missed []string
+	if b == nil || !b.loginSet {
+		missed = append(missed, "Login")
+	}
+	if b == nil || !b.emailSet {
+		missed = append(missed, "Email")
+	}
+	if len(missed) > 0 {
+		return nil, errors.New("required fields are not set: " + strings.Join(missed, ", "))
+	}
+	return &Account[ID]{
+		Model: &Model[ID]{
+			ID:        b.id,
+			CreatedAt: b.createdAt,
+			UpdatedAt: b.updatedAt,
+		},
+		Login: b.login,
+		Email: b.email,
+		Name:  b.name,
+	}, nil
+}
+
+func (b *AccountBuilder[ID]) ID(id ID) *AccountBuilder[ID] {
+	if b != nil {
+		b.id = id
+	}
+	return b
+}
+
+func (b *AccountBuilder[ID]) CreatedAt(createdAt int64) *AccountBuilder[ID] {
+	if b != nil {
+		b.createdAt = createdAt
+	}
+	return b
+}
+
+func (b *AccountBuilder[ID]) UpdatedAt(updatedAt int64) *AccountBuilder[ID] {
+	if b != nil {
+		b.updatedAt = updatedAt
+	}
+	return b
+}
+
+func (b *AccountBuilder[ID]) Login(login string) *AccountBuilder[ID] {
+	if b != nil {
+		b.login = login
+		b.loginSet = true
+	}
+	return b
+}
+
+func (b *AccountBuilder[ID]) Email(email string) *AccountBuilder[ID] {
+	if b != nil {
+		b.email = email
+		b.emailSet = true
+	}
+	return b
+}
+
+func (b *AccountBuilder[ID]) Name(name string) *AccountBuilder[ID] {
+	if b != nil {
+		b.name = name
+	}
+	return b
+}
+
+func (a *Account[ID]) ToBuilder() *AccountBuilder[ID] {
+	if a == nil {
+		return &AccountBuilder[ID]{}
+	}
+	var (
+		a_Model_ID        ID
+		a_Model_CreatedAt int64
+		a_Model_UpdatedAt int64
+	)
+	if m := a.Model; m != nil {
+		a_Model_ID = m.ID
+		a_Model_CreatedAt = m.CreatedAt
+		a_Model_UpdatedAt = m.UpdatedAt
+	}
+
+	return &AccountBuilder[ID]{
+		id:        a_Model_ID,
+		createdAt: a_Model_CreatedAt,
+		updatedAt: a_Model_UpdatedAt,
+		login:     a.Login,
+		email:     a.Email,
+		name:      a.Name,
+		loginSet:  true,
+		emailSet:  true,
+	}
+}
@@ -0,0 +1,28 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AccountBuilderRequired(t *testing.T) {
+	_, err := NewAccountBuilder[int]().Name("name").Build()
+	assert.EqualError(t, err, "required fields are not set: Login, Email")
+
+	_, err = NewAccountBuilder[int]().Login("login").Build()
+	assert.EqualError(t, err, "required fields are not set: Email")
+
+	var nilBuilder *AccountBuilder[int]
+	_, err = nilBuilder.Build()
+	assert.Error(t, err)
+
+	account, err := NewAccountBuilder[int]().ID(1).Login("login").Email("").Build()
+	require.NoError(t, err)
+	assert.Equal(t, &Account[int]{Model: &Model[int]{ID: 1}, Login: "login"}, account)
+
+	restored, err := account.ToBuilder().Build()
+	require.NoError(t, err)
+	assert.Equal(t, account, restored)
+}
@@ -0,0 +1,11 @@
+package builder
+
+const DefaultNote = "none"
+
+//go:generate fieldr -type Order builder -staged -collections -required ID -required Customer
+type Order[ID comparable] struct {
+	ID       ID
+	Customer string
+	Items    []string
+	Note     string `default:"DefaultNote"`
+}
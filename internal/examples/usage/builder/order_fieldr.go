@@ -0,0 +1,88 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package builder
+
+import "slices"
+
+type OrderBuilderWithID[ID comparable] interface {
+	ID(id ID) OrderBuilderWithCustomer[ID]
+}
+
+type OrderBuilderWithCustomer[ID comparable] interface {
+	Customer(customer string) OrderBuilderOptional[ID]
+}
+
+type OrderBuilderOptional[ID comparable] interface {
+	Items(items []string) OrderBuilderOptional[ID]
+	AddItems(items ...string) OrderBuilderOptional[ID]
+	RemoveItems(item string) OrderBuilderOptional[ID]
+	Note(note string) OrderBuilderOptional[ID]
+	Build() *Order[ID]
+}
+
+type OrderBuilder[ID comparable] struct {
+	id       ID
+	customer string
+	items    []string
+	note     string
+}
+
+func NewOrderBuilder[ID comparable]() OrderBuilderWithID[ID] {
+	return &OrderBuilder[ID]{
+		note: DefaultNote,
+	}
+}
+
+func (b *OrderBuilder[ID]) Build() *Order[ID] {
+	if b == nil {
+		return &Order[ID]{}
+	}
+	return &Order[ID]{
+		ID:       b.id,
+		Customer: b.customer,
+		Items:    b.items,
+		Note:     b.note,
+	}
+}
+
+func (b *OrderBuilder[ID]) ID(id ID) OrderBuilderWithCustomer[ID] {
+	if b != nil {
+		b.id = id
+	}
+	return b
+}
+
+func (b *OrderBuilder[ID]) Customer(customer string) OrderBuilderOptional[ID] {
+	if b != nil {
+		b.customer = customer
+	}
+	return b
+}
+
+func (b *OrderBuilder[ID]) Items(items []string) OrderBuilderOptional[ID] {
+	if b != nil {
+		b.items = items
+	}
+	return b
+}
+
+func (b *OrderBuilder[ID]) AddItems(items ...string) OrderBuilderOptional[ID] {
+	if b != nil {
+		b.items = append(b.items, items...)
+	}
+	return b
+}
+
+func (b *OrderBuilder[ID]) RemoveItems(item string) OrderBuilderOptional[ID] {
+	if b != nil {
+		b.items = slices.DeleteFunc(b.items, func(e string) bool { return e == item })
+	}
+	return b
+}
+
+func (b *OrderBuilder[ID]) Note(note string) OrderBuilderOptional[ID] {
+	if b != nil {
+		b.note = note
+	}
+	return b
+}
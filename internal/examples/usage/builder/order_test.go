@@ -0,0 +1,18 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderStagedBuilder(t *testing.T) {
+	order := NewOrderBuilder[int]().ID(1).Customer("customer").Note("note").Build()
+	assert.Equal(t, &Order[int]{ID: 1, Customer: "customer", Note: "note"}, order)
+
+	var optional OrderBuilderOptional[int] = NewOrderBuilder[int]().ID(2).Customer("")
+	assert.Equal(t, &Order[int]{ID: 2, Items: []string{"item"}, Note: DefaultNote}, optional.Items([]string{"item"}).Build())
+
+	order = NewOrderBuilder[int]().ID(3).Customer("").AddItems("a", "b", "a").RemoveItems("a").Build()
+	assert.Equal(t, []string{"b"}, order.Items)
+}
@@ -0,0 +1,11 @@
+package decorator
+
+import "context"
+
+//go:generate fieldr -type Repository decorator
+
+type Repository interface {
+	Get(ctx context.Context, id int) (string, error)
+	Save(ctx context.Context, names ...string) error
+	Reset()
+}
@@ -0,0 +1,43 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package decorator
+
+import "context"
+
+type RepositoryDecorator struct {
+	Repository
+	Before func(method string, args ...any)
+	After  func(method string, results ...any)
+}
+
+func (d RepositoryDecorator) Get(ctx context.Context, id int) (string, error) {
+	if d.Before != nil {
+		d.Before("Get", ctx, id)
+	}
+	r0, r1 := d.Repository.Get(ctx, id)
+	if d.After != nil {
+		d.After("Get", r0, r1)
+	}
+	return r0, r1
+}
+
+func (d RepositoryDecorator) Reset() {
+	if d.Before != nil {
+		d.Before("Reset")
+	}
+	d.Repository.Reset()
+	if d.After != nil {
+		d.After("Reset")
+	}
+}
+
+func (d RepositoryDecorator) Save(ctx context.Context, names ...string) error {
+	if d.Before != nil {
+		d.Before("Save", ctx, names)
+	}
+	r0 := d.Repository.Save(ctx, names...)
+	if d.After != nil {
+		d.After("Save", r0)
+	}
+	return r0
+}
@@ -0,0 +1,66 @@
+package decorator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type repository struct {
+	names []string
+}
+
+func (r *repository) Get(_ context.Context, id int) (string, error) {
+	if id < 0 || id >= len(r.names) {
+		return "", errors.New("not found")
+	}
+	return r.names[id], nil
+}
+
+func (r *repository) Save(_ context.Context, names ...string) error {
+	r.names = append(r.names, names...)
+	return nil
+}
+
+func (r *repository) Reset() { r.names = nil }
+
+func Test_RepositoryDecorator(t *testing.T) {
+	type hook struct {
+		method string
+		values []any
+	}
+	var (
+		ctx   = context.Background()
+		calls []hook
+		repo  Repository = RepositoryDecorator{
+			Repository: &repository{},
+			Before:     func(method string, args ...any) { calls = append(calls, hook{"before " + method, args}) },
+			After:      func(method string, results ...any) { calls = append(calls, hook{"after " + method, results}) },
+		}
+	)
+
+	assert.NoError(t, repo.Save(ctx, "first", "second"))
+	name, err := repo.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "second", name)
+	repo.Reset()
+
+	assert.Equal(t, []hook{
+		{"before Save", []any{ctx, []string{"first", "second"}}},
+		{"after Save", []any{nil}},
+		{"before Get", []any{ctx, 1}},
+		{"after Get", []any{"second", nil}},
+		{"before Reset", nil},
+		{"after Reset", nil},
+	}, calls)
+}
+
+func Test_RepositoryDecoratorNoHooks(t *testing.T) {
+	repo := RepositoryDecorator{Repository: &repository{}}
+
+	assert.NoError(t, repo.Save(context.Background(), "first"))
+	_, err := repo.Get(context.Background(), 1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,13 @@
+package enrich_enum
+
+//go:generate fieldr -type EnumWithAliases enrich-const-type -export -duplicates canonical -canonical Medium -api getter -api aliases -api all -api from-name -api from-value
+
+type EnumWithAliases int
+
+const (
+	Low EnumWithAliases = iota
+	Medium
+	High
+	Average = Medium
+	Normal  = Medium
+)
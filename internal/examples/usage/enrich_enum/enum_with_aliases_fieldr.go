@@ -0,0 +1,63 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enrich_enum
+
+func (e EnumWithAliases) Name() string {
+	switch e {
+	case Medium:
+		return "Medium"
+	case High:
+		return "High"
+	case Low:
+		return "Low"
+	default:
+		return ""
+	}
+}
+
+func (e EnumWithAliases) Aliases() []string {
+	switch e {
+	case Medium:
+		return []string{"Average", "Normal"}
+	default:
+		return nil
+	}
+}
+
+func EnumWithAliasesAll() []EnumWithAliases {
+	return []EnumWithAliases{
+		Medium, //Average, Normal
+		High,
+		Low,
+	}
+}
+
+func EnumWithAliasesByName(name string) (e EnumWithAliases, ok bool) {
+	ok = true
+	switch name {
+	case "Medium", "Average", "Normal":
+		e = Medium
+	case "High":
+		e = High
+	case "Low":
+		e = Low
+	default:
+		ok = false
+	}
+	return
+}
+
+func EnumWithAliasesByValue(value int) (e EnumWithAliases, ok bool) {
+	ok = true
+	switch value {
+	case 1:
+		e = Medium
+	case 2:
+		e = High
+	case 0:
+		e = Low
+	default:
+		ok = false
+	}
+	return
+}
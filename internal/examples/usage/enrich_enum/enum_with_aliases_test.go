@@ -0,0 +1,21 @@
+package enrich_enum
+
+import (
+	"testing"
+
+	"github.com/m4gshm/gollections/slice"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EnumWithAliases(t *testing.T) {
+	values := EnumWithAliasesAll()
+
+	assert.Equal(t, slice.Of(Medium, High, Low), values)
+
+	assert.Equal(t, slice.Of("Medium", "High", "Low"), slice.Convert(values, EnumWithAliases.Name))
+	assert.Equal(t, "Medium", Normal.Name())
+	assert.Equal(t, []string{"Average", "Normal"}, Medium.Aliases())
+	assert.Nil(t, Low.Aliases())
+
+	assert.Equal(t, slice.Of(Medium, Medium, Medium, Low), slice.ConvertOK(slice.Of("Average", "Medium", "Normal", "Low"), EnumWithAliasesByName))
+}
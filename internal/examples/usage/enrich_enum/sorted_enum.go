@@ -0,0 +1,11 @@
+package enrich_enum
+
+//go:generate fieldr -type Priority enrich-const-type -export -sort "name"
+
+type Priority int
+
+const (
+	PriorityMedium Priority = iota + 1
+	PriorityHigh
+	PriorityLow
+)
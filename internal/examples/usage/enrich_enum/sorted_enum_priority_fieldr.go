@@ -0,0 +1,54 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enrich_enum
+
+func (p Priority) Name() string {
+	switch p {
+	case PriorityHigh:
+		return "PriorityHigh"
+	case PriorityLow:
+		return "PriorityLow"
+	case PriorityMedium:
+		return "PriorityMedium"
+	default:
+		return ""
+	}
+}
+
+func PriorityAll() []Priority {
+	return []Priority{
+		PriorityHigh,
+		PriorityLow,
+		PriorityMedium,
+	}
+}
+
+func PriorityByName(name string) (e Priority, ok bool) {
+	ok = true
+	switch name {
+	case "PriorityHigh":
+		e = PriorityHigh
+	case "PriorityLow":
+		e = PriorityLow
+	case "PriorityMedium":
+		e = PriorityMedium
+	default:
+		ok = false
+	}
+	return
+}
+
+func PriorityByValue(value int) (e Priority, ok bool) {
+	ok = true
+	switch value {
+	case 2:
+		e = PriorityHigh
+	case 3:
+		e = PriorityLow
+	case 1:
+		e = PriorityMedium
+	default:
+		ok = false
+	}
+	return
+}
@@ -0,0 +1,11 @@
+package enrich_enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SortedEnumValues(t *testing.T) {
+	assert.Equal(t, []Priority{PriorityHigh, PriorityLow, PriorityMedium}, PriorityAll())
+}
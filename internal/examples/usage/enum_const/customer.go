@@ -0,0 +1,9 @@
+package enum_const
+
+//go:generate fieldr -type Customer -out customer_fieldr.go fields-to-consts -type customerColumn -val "concat([tag.db.name], tag.db.options)" -name "'cust' + field.name + (index > 0 ? string(index) : '')" -list . -ref-access .
+//go:generate fieldr -type Customer -out customer_names_fieldr.go fields-to-consts -type customerName -val "{'Json': tag.json.name, 'Db': tag.db.name}" -name "field.name + key" -check-unique-val=false -list . -val-access .
+
+type Customer struct {
+	ID   int    `db:"id,customer_id" json:"id"`
+	Name string `db:"name,full_name,title" json:"customer"`
+}
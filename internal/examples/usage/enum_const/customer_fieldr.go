@@ -0,0 +1,41 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type customerColumn string
+
+const (
+	custID    customerColumn = "id"
+	custID1   customerColumn = "customer_id"
+	custName  customerColumn = "name"
+	custName1 customerColumn = "full_name"
+	custName2 customerColumn = "title"
+)
+
+func customerColumns() []customerColumn {
+	return []customerColumn{
+		custID,
+		custID1,
+		custName,
+		custName1,
+		custName2}
+}
+
+func (s *Customer) ref(f customerColumn) any {
+	if s == nil {
+		return nil
+	}
+	switch f {
+	case custID:
+		return &s.ID
+	case custID1:
+		return &s.ID
+	case custName:
+		return &s.Name
+	case custName1:
+		return &s.Name
+	case custName2:
+		return &s.Name
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type customerName string
+
+const (
+	IDDb     customerName = "id"
+	IDJson   customerName = "id"
+	NameDb   customerName = "name"
+	NameJson customerName = "customer"
+)
+
+func customerNames() []customerName {
+	return []customerName{
+		IDDb,
+		IDJson,
+		NameDb,
+		NameJson}
+}
+
+func (s *Customer) val(f customerName) any {
+	if s == nil {
+		return nil
+	}
+	switch f {
+	case IDDb:
+		return s.ID
+	case NameDb:
+		return s.Name
+	case NameJson:
+		return s.Name
+	}
+	return nil
+}
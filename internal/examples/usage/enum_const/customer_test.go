@@ -0,0 +1,18 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CustomerMultiConstants(t *testing.T) {
+	assert.Equal(t, []customerColumn{"id", "customer_id", "name", "full_name", "title"}, customerColumns())
+	assert.Equal(t, []customerName{"id", "id", "name", "customer"}, customerNames())
+
+	customer := &Customer{ID: 1, Name: "name"}
+	assert.Equal(t, &customer.ID, customer.ref(custID1))
+	assert.Equal(t, &customer.Name, customer.ref(custName2))
+	assert.Equal(t, 1, customer.val(IDJson))
+	assert.Equal(t, "name", customer.val(NameJson))
+}
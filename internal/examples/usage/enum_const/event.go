@@ -0,0 +1,20 @@
+package enum_const
+
+import "time"
+
+//go:generate fieldr -type Event -out event_fieldr.go fields-to-consts -type timeField -name "'time'+field.name" -val "field.typeName" -include "field.exported && field.base == 'time.Time'" -list .
+//go:generate fieldr -type Event -out event_doc_fieldr.go fields-to-consts -type docField -name "'doc'+field.name" -val "field.path + ':' + field.kind + ':' + OR(field.comment, field.doc)" -include "field.comment != '' || field.doc != ''" -flat Audit -list .
+
+type Event struct {
+	Name string // event name
+	// Tags are the event labels.
+	Tags      map[string]string
+	Occurred  time.Time
+	Processed *time.Time
+	created   time.Time
+	Audit     *Audit
+}
+
+type Audit struct {
+	Author string // author login
+}
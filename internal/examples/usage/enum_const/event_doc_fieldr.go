@@ -0,0 +1,15 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type docField string
+
+const (
+	docName   docField = ":basic:event name"
+	docTags   docField = ":map:Tags are the event labels."
+	docAuthor docField = "Audit:basic:author login"
+)
+
+func docFields() []docField {
+	return []docField{docName, docTags, docAuthor}
+}
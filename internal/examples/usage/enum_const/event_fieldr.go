@@ -0,0 +1,14 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type timeField string
+
+const (
+	timeOccurred  timeField = "time.Time"
+	timeProcessed timeField = "*time.Time"
+)
+
+func timeFields() []timeField {
+	return []timeField{timeOccurred, timeProcessed}
+}
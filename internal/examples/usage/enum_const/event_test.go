@@ -0,0 +1,12 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EventFieldsEnv(t *testing.T) {
+	assert.Equal(t, []timeField{"time.Time", "*time.Time"}, timeFields())
+	assert.Equal(t, []docField{":basic:event name", ":map:Tags are the event labels.", "Audit:basic:author login"}, docFields())
+}
@@ -0,0 +1,15 @@
+package enum_const
+
+//go:generate fieldr -type Item -out item_fieldr.go fields-to-consts -type itemColumn -val "tag.db" -flat Owner -list . -val-access . -set-access . -typed-access . -for-each .
+
+type Owner struct {
+	Name string `db:"owner_name"`
+}
+
+type Item[ID comparable] struct {
+	ID    ID      `db:"id"`
+	Title string  `db:"title"`
+	Price float64 `db:"price"`
+	Stock int     `db:"stock"`
+	Owner *Owner
+}
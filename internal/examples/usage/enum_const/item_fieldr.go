@@ -0,0 +1,209 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+import "fmt"
+
+type itemColumn string
+
+const (
+	itemDbID    itemColumn = "id"
+	itemDbTitle itemColumn = "title"
+	itemDbPrice itemColumn = "price"
+	itemDbStock itemColumn = "stock"
+	itemDbName  itemColumn = "owner_name"
+)
+
+func itemColumns() []itemColumn {
+	return []itemColumn{
+		itemDbID,
+		itemDbTitle,
+		itemDbPrice,
+		itemDbStock,
+		itemDbName}
+}
+
+func (s *Item[ID]) val(f itemColumn) any {
+	if s == nil {
+		return nil
+	}
+	switch f {
+	case itemDbID:
+		return s.ID
+	case itemDbTitle:
+		return s.Title
+	case itemDbPrice:
+		return s.Price
+	case itemDbStock:
+		return s.Stock
+	case itemDbName:
+		if o := s.Owner; o != nil {
+			return o.Name
+		}
+	}
+	return nil
+}
+
+func (s *Item[ID]) setVal(f itemColumn, v any) error {
+	if s == nil {
+		return fmt.Errorf("set %v: nil Item", f)
+	}
+	switch f {
+	case itemDbID:
+		val, ok := v.(ID)
+		if !ok {
+			return fmt.Errorf("set %v: unexpected value type %T, expected ID", f, v)
+		}
+		s.ID = val
+		return nil
+	case itemDbTitle:
+		val, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("set %v: unexpected value type %T, expected string", f, v)
+		}
+		s.Title = val
+		return nil
+	case itemDbPrice:
+		val, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("set %v: unexpected value type %T, expected float64", f, v)
+		}
+		s.Price = val
+		return nil
+	case itemDbStock:
+		val, ok := v.(int)
+		if !ok {
+			return fmt.Errorf("set %v: unexpected value type %T, expected int", f, v)
+		}
+		s.Stock = val
+		return nil
+	case itemDbName:
+		val, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("set %v: unexpected value type %T, expected string", f, v)
+		}
+		if o := s.Owner; o != nil {
+			o.Name = val
+			return nil
+		}
+		return fmt.Errorf("set %v: nil field path", f)
+	}
+	return fmt.Errorf("set %v: unknown field", f)
+}
+
+func (s *Item[ID]) forEachField(f func(itemColumn, any) bool) {
+	if s == nil {
+		return
+	}
+	if !f(itemDbID, s.ID) {
+		return
+	}
+	if !f(itemDbTitle, s.Title) {
+		return
+	}
+	if !f(itemDbPrice, s.Price) {
+		return
+	}
+	if !f(itemDbStock, s.Stock) {
+		return
+	}
+	{
+		var v any
+		if o := s.Owner; o != nil {
+			v = o.Name
+		}
+		if !f(itemDbName, v) {
+			return
+		}
+	}
+}
+
+func valAs[V any, ID comparable](s *Item[ID], f itemColumn) (v V, ok bool) {
+	if s == nil {
+		return v, false
+	}
+	switch f {
+	case itemDbID:
+		p, ok := any(&v).(*ID)
+		if !ok {
+			return v, false
+		}
+		*p = s.ID
+		return v, true
+	case itemDbTitle, itemDbName:
+		p, ok := any(&v).(*string)
+		if !ok {
+			return v, false
+		}
+		switch f {
+		case itemDbTitle:
+			*p = s.Title
+			return v, true
+		case itemDbName:
+			if o := s.Owner; o != nil {
+				*p = o.Name
+				return v, true
+			}
+		}
+	case itemDbPrice:
+		p, ok := any(&v).(*float64)
+		if !ok {
+			return v, false
+		}
+		*p = s.Price
+		return v, true
+	case itemDbStock:
+		p, ok := any(&v).(*int)
+		if !ok {
+			return v, false
+		}
+		*p = s.Stock
+		return v, true
+	}
+	return v, false
+}
+
+func setValAs[V any, ID comparable](s *Item[ID], f itemColumn, v V) bool {
+	if s == nil {
+		return false
+	}
+	switch f {
+	case itemDbID:
+		p, ok := any(&v).(*ID)
+		if !ok {
+			return false
+		}
+		s.ID = *p
+		return true
+	case itemDbTitle, itemDbName:
+		p, ok := any(&v).(*string)
+		if !ok {
+			return false
+		}
+		switch f {
+		case itemDbTitle:
+			s.Title = *p
+			return true
+		case itemDbName:
+			if o := s.Owner; o != nil {
+				o.Name = *p
+				return true
+			}
+		}
+	case itemDbPrice:
+		p, ok := any(&v).(*float64)
+		if !ok {
+			return false
+		}
+		s.Price = *p
+		return true
+	case itemDbStock:
+		p, ok := any(&v).(*int)
+		if !ok {
+			return false
+		}
+		s.Stock = *p
+		return true
+	}
+	return false
+}
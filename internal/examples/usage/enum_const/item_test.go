@@ -0,0 +1,52 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ItemConstAccessors(t *testing.T) {
+	item := &Item[string]{ID: "1", Title: "item", Price: 1.5}
+
+	assert.NoError(t, item.setVal(itemDbStock, 10))
+	assert.Equal(t, 10, item.Stock)
+	assert.EqualError(t, item.setVal(itemDbStock, "10"), "set stock: unexpected value type string, expected int")
+	assert.EqualError(t, item.setVal(itemDbName, "owner"), "set owner_name: nil field path")
+	assert.EqualError(t, item.setVal("unknown", 1), "set unknown: unknown field")
+
+	item.Owner = &Owner{}
+	assert.NoError(t, item.setVal(itemDbName, "owner"))
+	assert.Equal(t, "owner", item.val(itemDbName))
+
+	values := map[itemColumn]any{}
+	item.forEachField(func(c itemColumn, v any) bool {
+		values[c] = v
+		return true
+	})
+	assert.Equal(t, map[itemColumn]any{
+		itemDbID: "1", itemDbTitle: "item", itemDbPrice: 1.5, itemDbStock: 10, itemDbName: "owner",
+	}, values)
+
+	var visited []itemColumn
+	item.forEachField(func(c itemColumn, _ any) bool {
+		visited = append(visited, c)
+		return len(visited) < 2
+	})
+	assert.Equal(t, []itemColumn{itemDbID, itemDbTitle}, visited)
+
+	title, ok := valAs[string](item, itemDbTitle)
+	assert.True(t, ok)
+	assert.Equal(t, "item", title)
+	id, ok := valAs[string](item, itemDbID)
+	assert.True(t, ok)
+	assert.Equal(t, "1", id)
+	_, ok = valAs[int](item, itemDbTitle)
+	assert.False(t, ok)
+
+	assert.True(t, setValAs(item, itemDbPrice, 2.5))
+	assert.Equal(t, 2.5, item.Price)
+	assert.True(t, setValAs(item, itemDbName, "new owner"))
+	assert.Equal(t, "new owner", item.Owner.Name)
+	assert.False(t, setValAs(item, itemDbPrice, 2))
+}
@@ -0,0 +1,18 @@
+package enum_const
+
+import "time"
+
+//go:generate fieldr -type Order -out order_fieldr.go fields-to-consts -var -name "'Order' + field.name" -val "'Column[' + struct.type + ', ' + field.typeName + ']{Name: ' + quote(tag.db) + ', Get: func(o *' + struct.type + ') ' + field.typeName + ' { return o.' + field.name + ' }}'" -include "tag.db != ''"
+
+// Column is the typed table column descriptor of a query builder.
+type Column[T any, V any] struct {
+	Name string
+	Get  func(*T) V
+}
+
+type Order struct {
+	ID       int       `db:"id"`
+	Customer string    `db:"customer"`
+	Created  time.Time `db:"created"`
+	Draft    bool
+}
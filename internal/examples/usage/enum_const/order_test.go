@@ -0,0 +1,20 @@
+package enum_const
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OrderColumnVars(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	order := &Order{ID: 1, Customer: "customer", Created: created}
+
+	assert.Equal(t, "id", OrderID.Name)
+	assert.Equal(t, 1, OrderID.Get(order))
+	assert.Equal(t, "customer", OrderCustomer.Name)
+	assert.Equal(t, "customer", OrderCustomer.Get(order))
+	assert.Equal(t, "created", OrderCreated.Name)
+	assert.Equal(t, created, OrderCreated.Get(order))
+}
@@ -0,0 +1,12 @@
+package enum_const
+
+//go:generate fieldr -type Product -out product_fieldr.go fields-to-consts -type productColumn -name "'col'+field.name" -val "tag.gorm.value('column')" -include "tag.gorm != ''" -list .
+//go:generate fieldr -type Product -out product_json_fieldr.go fields-to-consts -type productJson -name "'json'+field.name" -val "tag.json.name" -include "tag.json?.name != '-' && !(tag.json?.has('omitempty') ?? false)" -list .
+
+type Product struct {
+	ID    int     `json:"id" gorm:"column:product_id;primaryKey"`
+	Title string  `json:"title" gorm:"column:title;default:'no;title'"`
+	Price float64 `json:"price,omitempty" gorm:"column:price"`
+	Note  string  `json:"-"`
+	Label string  `json:"label,omitempty"`
+}
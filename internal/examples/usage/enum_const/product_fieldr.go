@@ -0,0 +1,15 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type productColumn string
+
+const (
+	colID    productColumn = "product_id"
+	colTitle productColumn = "title"
+	colPrice productColumn = "price"
+)
+
+func productColumns() []productColumn {
+	return []productColumn{colID, colTitle, colPrice}
+}
@@ -0,0 +1,14 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type productJson string
+
+const (
+	jsonID    productJson = "id"
+	jsonTitle productJson = "title"
+)
+
+func productJsons() []productJson {
+	return []productJson{jsonID, jsonTitle}
+}
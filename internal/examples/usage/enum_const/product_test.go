@@ -0,0 +1,12 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProductTagOptions(t *testing.T) {
+	assert.Equal(t, []productColumn{"product_id", "title", "price"}, productColumns())
+	assert.Equal(t, []productJson{"id", "title"}, productJsons())
+}
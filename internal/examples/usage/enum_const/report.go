@@ -0,0 +1,11 @@
+package enum_const
+
+//go:generate fieldr -type Report -out report_fieldr.go fields-to-consts -type reportColumn -val "tag.db" -sort "[tag.order != '' ? int(tag.order) : 100, value]" -list .
+//go:generate fieldr -type Report -out report_as_map_fieldr.go as-map -key-type . -sort "name"
+
+type Report struct {
+	Title  string `db:"title" order:"2"`
+	ID     int    `db:"id" order:"1"`
+	Body   string `db:"body"`
+	Author string `db:"author"`
+}
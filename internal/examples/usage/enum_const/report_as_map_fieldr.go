@@ -0,0 +1,24 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type reportField string
+
+const (
+	author reportField = "Author"
+	body   reportField = "Body"
+	id     reportField = "ID"
+	title  reportField = "Title"
+)
+
+func (r *Report) asMap() map[reportField]any {
+	if r == nil {
+		return nil
+	}
+	m := map[reportField]any{}
+	m[author] = r.Author
+	m[body] = r.Body
+	m[id] = r.ID
+	m[title] = r.Title
+	return m
+}
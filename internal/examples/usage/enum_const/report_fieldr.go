@@ -0,0 +1,20 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type reportColumn string
+
+const (
+	reportDbID     reportColumn = "id"
+	reportDbTitle  reportColumn = "title"
+	reportDbAuthor reportColumn = "author"
+	reportDbBody   reportColumn = "body"
+)
+
+func reportColumns() []reportColumn {
+	return []reportColumn{
+		reportDbID,
+		reportDbTitle,
+		reportDbAuthor,
+		reportDbBody}
+}
@@ -0,0 +1,13 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReportSortedConstants(t *testing.T) {
+	assert.Equal(t, []reportColumn{"id", "title", "author", "body"}, reportColumns())
+	assert.Equal(t, map[reportField]any{"Author": "a", "Body": "b", "ID": 1, "Title": "t"},
+		(&Report{ID: 1, Title: "t", Body: "b", Author: "a"}).asMap())
+}
@@ -0,0 +1,28 @@
+package enum_const
+
+import "time"
+
+//go:generate fieldr -type Shipment -out shipment_fieldr.go fields-to-consts -flat-all -path-name-sep _ -type shipmentPath -val "field.fullPath" -list . -val-access .
+//go:generate fieldr -type Shipment -out shipment_json_fieldr.go fields-to-consts -flat-all -flat-depth 1 -path-val-sep / -type shipmentJsonPath -name "'shipmentJson' + field.path + name" -val "field.fullPath" -sort "-len(split(field.fullPath, '/'))" -list .
+
+type Country struct {
+	Code string
+}
+
+type City struct {
+	Name    string
+	Country *Country
+}
+
+type Address struct {
+	Street string
+	City   *City
+}
+
+type Shipment struct {
+	ID      int
+	From    Address
+	To      *Address
+	Created time.Time
+	Parent  *Shipment
+}
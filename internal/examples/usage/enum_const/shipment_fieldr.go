@@ -0,0 +1,77 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type shipmentPath string
+
+const (
+	shipmentID                     shipmentPath = "ID"
+	shipmentFrom_Street            shipmentPath = "From.Street"
+	shipmentFrom_City_Name         shipmentPath = "From.City.Name"
+	shipmentFrom_City_Country_Code shipmentPath = "From.City.Country.Code"
+	shipmentTo_Street              shipmentPath = "To.Street"
+	shipmentTo_City_Name           shipmentPath = "To.City.Name"
+	shipmentTo_City_Country_Code   shipmentPath = "To.City.Country.Code"
+	shipmentCreated                shipmentPath = "Created"
+	shipmentParent                 shipmentPath = "Parent"
+)
+
+func shipmentPaths() []shipmentPath {
+	return []shipmentPath{
+		shipmentID,
+		shipmentFrom_Street,
+		shipmentFrom_City_Name,
+		shipmentFrom_City_Country_Code,
+		shipmentTo_Street,
+		shipmentTo_City_Name,
+		shipmentTo_City_Country_Code,
+		shipmentCreated,
+		shipmentParent}
+}
+
+func (s *Shipment) val(f shipmentPath) any {
+	if s == nil {
+		return nil
+	}
+	switch f {
+	case shipmentID:
+		return s.ID
+	case shipmentFrom_Street:
+		return s.From.Street
+	case shipmentFrom_City_Name:
+		if c := s.From.City; c != nil {
+			return c.Name
+		}
+	case shipmentFrom_City_Country_Code:
+		if c1 := s.From.City; c1 != nil {
+			if c12 := c1.Country; c12 != nil {
+				return c12.Code
+			}
+		}
+	case shipmentTo_Street:
+		if t := s.To; t != nil {
+			return t.Street
+		}
+	case shipmentTo_City_Name:
+		if t1 := s.To; t1 != nil {
+			if c123 := t1.City; c123 != nil {
+				return c123.Name
+			}
+		}
+	case shipmentTo_City_Country_Code:
+		if t12 := s.To; t12 != nil {
+			if c1234 := t12.City; c1234 != nil {
+				if c12345 := c1234.Country; c12345 != nil {
+					return c12345.Code
+				}
+			}
+		}
+	case shipmentCreated:
+		return s.Created
+	case shipmentParent:
+		if p := s.Parent; p != nil {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package enum_const
+
+type shipmentJsonPath string
+
+const (
+	shipmentJsonFromStreet shipmentJsonPath = "From/Street"
+	shipmentJsonFromCity   shipmentJsonPath = "From/City"
+	shipmentJsonToStreet   shipmentJsonPath = "To/Street"
+	shipmentJsonToCity     shipmentJsonPath = "To/City"
+	shipmentJsonID         shipmentJsonPath = "ID"
+	shipmentJsonCreated    shipmentJsonPath = "Created"
+	shipmentJsonParent     shipmentJsonPath = "Parent"
+)
+
+func shipmentJsonPaths() []shipmentJsonPath {
+	return []shipmentJsonPath{
+		shipmentJsonFromStreet,
+		shipmentJsonFromCity,
+		shipmentJsonToStreet,
+		shipmentJsonToCity,
+		shipmentJsonID,
+		shipmentJsonCreated,
+		shipmentJsonParent}
+}
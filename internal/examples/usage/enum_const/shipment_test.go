@@ -0,0 +1,26 @@
+package enum_const
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ShipmentFlatPaths(t *testing.T) {
+	assert.Equal(t, []shipmentPath{
+		"ID", "From.Street", "From.City.Name", "From.City.Country.Code",
+		"To.Street", "To.City.Name", "To.City.Country.Code", "Created", "Parent",
+	}, shipmentPaths())
+	assert.Equal(t, []shipmentJsonPath{"From/Street", "From/City", "To/Street", "To/City", "ID", "Created", "Parent"}, shipmentJsonPaths())
+	assert.Equal(t, shipmentJsonPath("From/City"), shipmentJsonFromCity)
+
+	shipment := &Shipment{ID: 1, From: Address{Street: "from", City: &City{Name: "city"}}}
+	assert.Equal(t, 1, shipment.val(shipmentID))
+	assert.Equal(t, "from", shipment.val(shipmentFrom_Street))
+	assert.Equal(t, "city", shipment.val(shipmentFrom_City_Name))
+	assert.Nil(t, shipment.val(shipmentFrom_City_Country_Code))
+	assert.Nil(t, shipment.val(shipmentTo_City_Name))
+
+	shipment.To = &Address{City: &City{Country: &Country{Code: "US"}}}
+	assert.Equal(t, "US", shipment.val(shipmentTo_City_Country_Code))
+}
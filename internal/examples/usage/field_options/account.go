@@ -0,0 +1,15 @@
+package field_options
+
+//go:generate fieldr -type Account -out account_get_set_fieldr.go get-set
+//go:generate fieldr -type Account -out account_builder_fieldr.go builder
+//go:generate fieldr -type Account -out account_new_opt_fieldr.go new-opt
+//go:generate fieldr -type Account -out account_new_full_fieldr.go new-full -name NewFullAccount
+//go:generate fieldr -type Account -out account_as_map_fieldr.go as-map -key-type . -export
+//go:generate fieldr -type Account -out account_consts_fieldr.go fields-to-consts -type AccountConst -name "'Account'+field.name" -val field.name -list .
+
+type Account struct {
+	id       string `fieldr:"getter=ID,nosetter,required"`
+	Name     string `fieldr:"setter=Rename"`
+	Email    string `fieldr:"noexport"`
+	Password string `fieldr:"-"`
+}
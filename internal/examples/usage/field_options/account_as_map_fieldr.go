@@ -0,0 +1,20 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package field_options
+
+type AccountField string
+
+const (
+	Name  AccountField = "Name"
+	Email AccountField = "Email"
+)
+
+func (a *Account) AsMap() map[AccountField]any {
+	if a == nil {
+		return nil
+	}
+	m := map[AccountField]any{}
+	m[Name] = a.Name
+	m[Email] = a.Email
+	return m
+}
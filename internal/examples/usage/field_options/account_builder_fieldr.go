@@ -0,0 +1,56 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package field_options
+
+import (
+	"errors"
+	"strings"
+)
+
+type AccountBuilder struct {
+	id    string
+	idSet bool
+	name  string
+	email string
+}
+
+func NewAccountBuilder() *AccountBuilder {
+	return &AccountBuilder{}
+}
+
+func (b *AccountBuilder) Build() (*Account, error) {
+	var missed []string
+	if b == nil || !b.idSet {
+		missed = append(missed, "id")
+	}
+	if len(missed) > 0 {
+		return nil, errors.New("required fields are not set: " + strings.Join(missed, ", "))
+	}
+	return &Account{
+		id:    b.id,
+		Name:  b.name,
+		Email: b.email,
+	}, nil
+}
+
+func (b *AccountBuilder) ID(id string) *AccountBuilder {
+	if b != nil {
+		b.id = id
+		b.idSet = true
+	}
+	return b
+}
+
+func (b *AccountBuilder) Rename(name string) *AccountBuilder {
+	if b != nil {
+		b.name = name
+	}
+	return b
+}
+
+func (b *AccountBuilder) setEmail(email string) *AccountBuilder {
+	if b != nil {
+		b.email = email
+	}
+	return b
+}
@@ -0,0 +1,14 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package field_options
+
+type AccountConst string
+
+const (
+	AccountName  AccountConst = "Name"
+	AccountEmail AccountConst = "Email"
+)
+
+func accountConsts() []AccountConst {
+	return []AccountConst{AccountName, AccountEmail}
+}
@@ -0,0 +1,42 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package field_options
+
+func (a *Account) ID() string {
+	if a != nil {
+		return a.id
+	}
+
+	var no string
+	return no
+}
+
+func (a *Account) GetName() string {
+	if a != nil {
+		return a.Name
+	}
+
+	var no string
+	return no
+}
+
+func (a *Account) Rename(name string) {
+	if a != nil {
+		a.Name = name
+	}
+}
+
+func (a *Account) getEmail() string {
+	if a != nil {
+		return a.Email
+	}
+
+	var no string
+	return no
+}
+
+func (a *Account) setEmail(email string) {
+	if a != nil {
+		a.Email = email
+	}
+}
@@ -0,0 +1,28 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package field_options
+
+func NewAccount(
+	id string,
+	opts ...func(*Account),
+) *Account {
+	r := &Account{
+		id: id,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func WithName(name string) func(a *Account) {
+	return func(a *Account) {
+		a.Name = name
+	}
+}
+
+func withEmail(email string) func(a *Account) {
+	return func(a *Account) {
+		a.Email = email
+	}
+}
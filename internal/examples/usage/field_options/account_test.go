@@ -0,0 +1,26 @@
+package field_options
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AccountFieldOptions(t *testing.T) {
+	account := NewAccount("1", WithName("name"), withEmail("name@example.com"))
+	account.Password = "secret"
+	account.Rename("renamed")
+	assert.Equal(t, "1", account.ID())
+	assert.Equal(t, "renamed", account.GetName())
+	assert.Equal(t, "name@example.com", account.getEmail())
+	assert.Equal(t, map[AccountField]any{Name: "renamed", Email: "name@example.com"}, account.AsMap())
+	assert.Equal(t, []AccountConst{AccountName, AccountEmail}, accountConsts())
+
+	_, err := NewAccountBuilder().Rename("name").Build()
+	assert.ErrorContains(t, err, "required fields are not set: id")
+
+	built, err := NewAccountBuilder().ID("2").setEmail("email").Build()
+	assert.NoError(t, err)
+	assert.Equal(t, &Account{id: "2", Email: "email"}, built)
+	assert.Equal(t, &Account{id: "3", Name: "name", Email: "email"}, NewFullAccount("3", "name", "email"))
+}
@@ -0,0 +1,8 @@
+package fields_meta
+
+//go:generate fieldr -type Page -out page_fieldr.go fields-meta
+
+type Page[T any] struct {
+	Items []T `json:"items"`
+	Total int `json:"total"`
+}
@@ -0,0 +1,163 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package fields_meta
+
+type pageField string
+
+const (
+	items pageField = "Items"
+	total pageField = "Total"
+)
+
+type pageFieldMeta[T any] struct {
+	Name  string
+	Field pageField
+	Type  string
+	Tags  map[string]string
+	Get   func(*Page[T]) any
+	Set   func(*Page[T], any) bool
+}
+
+func pageFieldsMeta[T any]() []pageFieldMeta[T] {
+	return []pageFieldMeta[T]{
+		{
+			Name:  "Items",
+			Field: items,
+			Type:  "[]T",
+			Tags:  map[string]string{"json": "items"},
+			Get: func(s *Page[T]) any {
+				if s == nil {
+					return nil
+				}
+				return s.Items
+			},
+			Set: func(s *Page[T], v any) bool {
+				val, ok := v.([]T)
+				if s == nil || !ok {
+					return false
+				}
+				s.Items = val
+				return true
+			},
+		},
+		{
+			Name:  "Total",
+			Field: total,
+			Type:  "int",
+			Tags:  map[string]string{"json": "total"},
+			Get: func(s *Page[T]) any {
+				if s == nil {
+					return nil
+				}
+				return s.Total
+			},
+			Set: func(s *Page[T], v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				s.Total = val
+				return true
+			},
+		},
+	}
+}
+
+func pageFieldMetaByName[T any](name string) (pageFieldMeta[T], bool) {
+	switch name {
+	case "Items":
+		return pageFieldMeta[T]{
+			Name:  "Items",
+			Field: items,
+			Type:  "[]T",
+			Tags:  map[string]string{"json": "items"},
+			Get: func(s *Page[T]) any {
+				if s == nil {
+					return nil
+				}
+				return s.Items
+			},
+			Set: func(s *Page[T], v any) bool {
+				val, ok := v.([]T)
+				if s == nil || !ok {
+					return false
+				}
+				s.Items = val
+				return true
+			},
+		}, true
+	case "Total":
+		return pageFieldMeta[T]{
+			Name:  "Total",
+			Field: total,
+			Type:  "int",
+			Tags:  map[string]string{"json": "total"},
+			Get: func(s *Page[T]) any {
+				if s == nil {
+					return nil
+				}
+				return s.Total
+			},
+			Set: func(s *Page[T], v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				s.Total = val
+				return true
+			},
+		}, true
+	}
+	return pageFieldMeta[T]{}, false
+}
+
+func pageFieldMetaByTag[T any](tag, value string) (pageFieldMeta[T], bool) {
+	switch tag {
+	case "json":
+		switch value {
+		case "items":
+			return pageFieldMeta[T]{
+				Name:  "Items",
+				Field: items,
+				Type:  "[]T",
+				Tags:  map[string]string{"json": "items"},
+				Get: func(s *Page[T]) any {
+					if s == nil {
+						return nil
+					}
+					return s.Items
+				},
+				Set: func(s *Page[T], v any) bool {
+					val, ok := v.([]T)
+					if s == nil || !ok {
+						return false
+					}
+					s.Items = val
+					return true
+				},
+			}, true
+		case "total":
+			return pageFieldMeta[T]{
+				Name:  "Total",
+				Field: total,
+				Type:  "int",
+				Tags:  map[string]string{"json": "total"},
+				Get: func(s *Page[T]) any {
+					if s == nil {
+						return nil
+					}
+					return s.Total
+				},
+				Set: func(s *Page[T], v any) bool {
+					val, ok := v.(int)
+					if s == nil || !ok {
+						return false
+					}
+					s.Total = val
+					return true
+				},
+			}, true
+		}
+	}
+	return pageFieldMeta[T]{}, false
+}
@@ -0,0 +1,19 @@
+package fields_meta
+
+import "time"
+
+//go:generate fieldr -type User -out user_fieldr.go fields-meta -export
+
+type Audit struct {
+	Created time.Time `json:"created"`
+	Version int       `json:"version" db:"version"`
+}
+
+type User struct {
+	*Audit
+	ID      int      `json:"id" db:"user_id"`
+	Name    string   `json:"name,omitempty" db:"name"`
+	Roles   []string `json:"roles"`
+	Manager *User    `json:"manager"`
+	token   string
+}
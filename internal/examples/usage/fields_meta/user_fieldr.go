@@ -0,0 +1,534 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package fields_meta
+
+import "time"
+
+type UserField string
+
+const (
+	AuditCreated UserField = "Created"
+	AuditVersion UserField = "Version"
+	ID           UserField = "ID"
+	Name         UserField = "Name"
+	Roles        UserField = "Roles"
+	Manager      UserField = "Manager"
+)
+
+type UserFieldMeta struct {
+	Name  string
+	Field UserField
+	Type  string
+	Tags  map[string]string
+	Get   func(*User) any
+	Set   func(*User, any) bool
+}
+
+func UserFieldsMeta() []UserFieldMeta {
+	return []UserFieldMeta{
+		{
+			Name:  "Created",
+			Field: AuditCreated,
+			Type:  "time.Time",
+			Tags:  map[string]string{"json": "created"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if a := s.Audit; a != nil {
+					return a.Created
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(time.Time)
+				if s == nil || !ok {
+					return false
+				}
+				if a := s.Audit; a != nil {
+					a.Created = val
+					return true
+				}
+				return false
+			},
+		},
+		{
+			Name:  "Version",
+			Field: AuditVersion,
+			Type:  "int",
+			Tags:  map[string]string{"db": "version", "json": "version"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if a := s.Audit; a != nil {
+					return a.Version
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				if a := s.Audit; a != nil {
+					a.Version = val
+					return true
+				}
+				return false
+			},
+		},
+		{
+			Name:  "ID",
+			Field: ID,
+			Type:  "int",
+			Tags:  map[string]string{"db": "user_id", "json": "id"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.ID
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				s.ID = val
+				return true
+			},
+		},
+		{
+			Name:  "Name",
+			Field: Name,
+			Type:  "string",
+			Tags:  map[string]string{"db": "name", "json": "name,omitempty"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.Name
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(string)
+				if s == nil || !ok {
+					return false
+				}
+				s.Name = val
+				return true
+			},
+		},
+		{
+			Name:  "Roles",
+			Field: Roles,
+			Type:  "[]string",
+			Tags:  map[string]string{"json": "roles"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.Roles
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.([]string)
+				if s == nil || !ok {
+					return false
+				}
+				s.Roles = val
+				return true
+			},
+		},
+		{
+			Name:  "Manager",
+			Field: Manager,
+			Type:  "*User",
+			Tags:  map[string]string{"json": "manager"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if m := s.Manager; m != nil {
+					return m
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(*User)
+				if s == nil || !ok {
+					return false
+				}
+				s.Manager = val
+				return true
+			},
+		},
+	}
+}
+
+func UserFieldMetaByName(name string) (UserFieldMeta, bool) {
+	switch name {
+	case "Created":
+		return UserFieldMeta{
+			Name:  "Created",
+			Field: AuditCreated,
+			Type:  "time.Time",
+			Tags:  map[string]string{"json": "created"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if a := s.Audit; a != nil {
+					return a.Created
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(time.Time)
+				if s == nil || !ok {
+					return false
+				}
+				if a := s.Audit; a != nil {
+					a.Created = val
+					return true
+				}
+				return false
+			},
+		}, true
+	case "Version":
+		return UserFieldMeta{
+			Name:  "Version",
+			Field: AuditVersion,
+			Type:  "int",
+			Tags:  map[string]string{"db": "version", "json": "version"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if a := s.Audit; a != nil {
+					return a.Version
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				if a := s.Audit; a != nil {
+					a.Version = val
+					return true
+				}
+				return false
+			},
+		}, true
+	case "ID":
+		return UserFieldMeta{
+			Name:  "ID",
+			Field: ID,
+			Type:  "int",
+			Tags:  map[string]string{"db": "user_id", "json": "id"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.ID
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(int)
+				if s == nil || !ok {
+					return false
+				}
+				s.ID = val
+				return true
+			},
+		}, true
+	case "Name":
+		return UserFieldMeta{
+			Name:  "Name",
+			Field: Name,
+			Type:  "string",
+			Tags:  map[string]string{"db": "name", "json": "name,omitempty"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.Name
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(string)
+				if s == nil || !ok {
+					return false
+				}
+				s.Name = val
+				return true
+			},
+		}, true
+	case "Roles":
+		return UserFieldMeta{
+			Name:  "Roles",
+			Field: Roles,
+			Type:  "[]string",
+			Tags:  map[string]string{"json": "roles"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				return s.Roles
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.([]string)
+				if s == nil || !ok {
+					return false
+				}
+				s.Roles = val
+				return true
+			},
+		}, true
+	case "Manager":
+		return UserFieldMeta{
+			Name:  "Manager",
+			Field: Manager,
+			Type:  "*User",
+			Tags:  map[string]string{"json": "manager"},
+			Get: func(s *User) any {
+				if s == nil {
+					return nil
+				}
+				if m := s.Manager; m != nil {
+					return m
+				}
+				return nil
+			},
+			Set: func(s *User, v any) bool {
+				val, ok := v.(*User)
+				if s == nil || !ok {
+					return false
+				}
+				s.Manager = val
+				return true
+			},
+		}, true
+	}
+	return UserFieldMeta{}, false
+}
+
+func UserFieldMetaByTag(tag, value string) (UserFieldMeta, bool) {
+	switch tag {
+	case "db":
+		switch value {
+		case "name":
+			return UserFieldMeta{
+				Name:  "Name",
+				Field: Name,
+				Type:  "string",
+				Tags:  map[string]string{"db": "name", "json": "name,omitempty"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					return s.Name
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(string)
+					if s == nil || !ok {
+						return false
+					}
+					s.Name = val
+					return true
+				},
+			}, true
+		case "user_id":
+			return UserFieldMeta{
+				Name:  "ID",
+				Field: ID,
+				Type:  "int",
+				Tags:  map[string]string{"db": "user_id", "json": "id"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					return s.ID
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(int)
+					if s == nil || !ok {
+						return false
+					}
+					s.ID = val
+					return true
+				},
+			}, true
+		case "version":
+			return UserFieldMeta{
+				Name:  "Version",
+				Field: AuditVersion,
+				Type:  "int",
+				Tags:  map[string]string{"db": "version", "json": "version"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					if a := s.Audit; a != nil {
+						return a.Version
+					}
+					return nil
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(int)
+					if s == nil || !ok {
+						return false
+					}
+					if a := s.Audit; a != nil {
+						a.Version = val
+						return true
+					}
+					return false
+				},
+			}, true
+		}
+	case "json":
+		switch value {
+		case "created":
+			return UserFieldMeta{
+				Name:  "Created",
+				Field: AuditCreated,
+				Type:  "time.Time",
+				Tags:  map[string]string{"json": "created"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					if a := s.Audit; a != nil {
+						return a.Created
+					}
+					return nil
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(time.Time)
+					if s == nil || !ok {
+						return false
+					}
+					if a := s.Audit; a != nil {
+						a.Created = val
+						return true
+					}
+					return false
+				},
+			}, true
+		case "id":
+			return UserFieldMeta{
+				Name:  "ID",
+				Field: ID,
+				Type:  "int",
+				Tags:  map[string]string{"db": "user_id", "json": "id"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					return s.ID
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(int)
+					if s == nil || !ok {
+						return false
+					}
+					s.ID = val
+					return true
+				},
+			}, true
+		case "manager":
+			return UserFieldMeta{
+				Name:  "Manager",
+				Field: Manager,
+				Type:  "*User",
+				Tags:  map[string]string{"json": "manager"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					if m := s.Manager; m != nil {
+						return m
+					}
+					return nil
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(*User)
+					if s == nil || !ok {
+						return false
+					}
+					s.Manager = val
+					return true
+				},
+			}, true
+		case "name":
+			return UserFieldMeta{
+				Name:  "Name",
+				Field: Name,
+				Type:  "string",
+				Tags:  map[string]string{"db": "name", "json": "name,omitempty"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					return s.Name
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(string)
+					if s == nil || !ok {
+						return false
+					}
+					s.Name = val
+					return true
+				},
+			}, true
+		case "roles":
+			return UserFieldMeta{
+				Name:  "Roles",
+				Field: Roles,
+				Type:  "[]string",
+				Tags:  map[string]string{"json": "roles"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					return s.Roles
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.([]string)
+					if s == nil || !ok {
+						return false
+					}
+					s.Roles = val
+					return true
+				},
+			}, true
+		case "version":
+			return UserFieldMeta{
+				Name:  "Version",
+				Field: AuditVersion,
+				Type:  "int",
+				Tags:  map[string]string{"db": "version", "json": "version"},
+				Get: func(s *User) any {
+					if s == nil {
+						return nil
+					}
+					if a := s.Audit; a != nil {
+						return a.Version
+					}
+					return nil
+				},
+				Set: func(s *User, v any) bool {
+					val, ok := v.(int)
+					if s == nil || !ok {
+						return false
+					}
+					if a := s.Audit; a != nil {
+						a.Version = val
+						return true
+					}
+					return false
+				},
+			}, true
+		}
+	}
+	return UserFieldMeta{}, false
+}
@@ -0,0 +1,63 @@
+package fields_meta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UserFieldsMeta(t *testing.T) {
+	meta := UserFieldsMeta()
+	names := make([]string, len(meta))
+	for i, m := range meta {
+		names[i] = m.Name
+	}
+	assert.Equal(t, []string{"Created", "Version", "ID", "Name", "Roles", "Manager"}, names)
+
+	user := &User{ID: 1, Name: "user"}
+
+	id, ok := UserFieldMetaByName("ID")
+	assert.True(t, ok)
+	assert.Equal(t, ID, id.Field)
+	assert.Equal(t, "int", id.Type)
+	assert.Equal(t, map[string]string{"db": "user_id", "json": "id"}, id.Tags)
+	assert.Equal(t, 1, id.Get(user))
+	assert.True(t, id.Set(user, 2))
+	assert.Equal(t, 2, user.ID)
+	assert.False(t, id.Set(user, "3"))
+
+	name, ok := UserFieldMetaByTag("json", "name")
+	assert.True(t, ok)
+	assert.Equal(t, "Name", name.Name)
+	assert.Equal(t, "user", name.Get(user))
+
+	version, ok := UserFieldMetaByTag("db", "version")
+	assert.True(t, ok)
+	assert.Nil(t, version.Get(user))
+	assert.False(t, version.Set(user, 1))
+	user.Audit = &Audit{}
+	assert.True(t, version.Set(user, 1))
+	assert.Equal(t, 1, user.Version)
+
+	manager, ok := UserFieldMetaByName("Manager")
+	assert.True(t, ok)
+	assert.Nil(t, manager.Get(user))
+	assert.True(t, manager.Set(user, &User{ID: 3}))
+	assert.Equal(t, 3, user.Manager.ID)
+
+	_, ok = UserFieldMetaByName("token")
+	assert.False(t, ok)
+	_, ok = UserFieldMetaByTag("json", "unknown")
+	assert.False(t, ok)
+}
+
+func Test_GenericFieldsMeta(t *testing.T) {
+	page := &Page[string]{Items: []string{"a"}, Total: 1}
+
+	items, ok := pageFieldMetaByTag[string]("json", "items")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a"}, items.Get(page))
+	assert.True(t, items.Set(page, []string{"b"}))
+	assert.Equal(t, []string{"b"}, page.Items)
+	assert.False(t, items.Set(page, []int{1}))
+}
@@ -0,0 +1,14 @@
+package get_set
+
+//go:generate fieldr -type Catalog get-set -collections
+
+type Catalog[K comparable, V any] struct {
+	*CatalogMeta
+	items  map[K]V
+	keys   []K
+	values []V
+}
+
+type CatalogMeta struct {
+	tags []string
+}
@@ -0,0 +1,118 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+import "slices"
+
+func (c *Catalog[K, V]) Tags() []string {
+	if c != nil {
+		if cm := c.CatalogMeta; cm != nil {
+			return cm.tags
+		}
+	}
+
+	var no []string
+	return no
+}
+
+func (c *Catalog[K, V]) SetTags(tags []string) {
+	if c != nil {
+		if cm := c.CatalogMeta; cm != nil {
+			cm.tags = tags
+		}
+	}
+}
+
+func (c *Catalog[K, V]) AddTags(items ...string) {
+	if c != nil {
+		if cm := c.CatalogMeta; cm != nil {
+			cm.tags = append(cm.tags, items...)
+		}
+	}
+}
+
+func (c *Catalog[K, V]) RemoveTags(item string) {
+	if c != nil {
+		if cm := c.CatalogMeta; cm != nil {
+			cm.tags = slices.DeleteFunc(cm.tags, func(e string) bool { return e == item })
+		}
+	}
+}
+
+func (c *Catalog[K, V]) Items() map[K]V {
+	if c != nil {
+		return c.items
+	}
+
+	var no map[K]V
+	return no
+}
+
+func (c *Catalog[K, V]) SetItems(items map[K]V) {
+	if c != nil {
+		c.items = items
+	}
+}
+
+func (c *Catalog[K, V]) PutItems(key K, value V) {
+	if c != nil {
+		if c.items == nil {
+			c.items = make(map[K]V)
+		}
+		c.items[key] = value
+	}
+}
+
+func (c *Catalog[K, V]) DeleteItems(key K) {
+	if c != nil {
+		delete(c.items, key)
+	}
+}
+
+func (c *Catalog[K, V]) Keys() []K {
+	if c != nil {
+		return c.keys
+	}
+
+	var no []K
+	return no
+}
+
+func (c *Catalog[K, V]) SetKeys(keys []K) {
+	if c != nil {
+		c.keys = keys
+	}
+}
+
+func (c *Catalog[K, V]) AddKeys(items ...K) {
+	if c != nil {
+		c.keys = append(c.keys, items...)
+	}
+}
+
+func (c *Catalog[K, V]) RemoveKeys(item K) {
+	if c != nil {
+		c.keys = slices.DeleteFunc(c.keys, func(e K) bool { return e == item })
+	}
+}
+
+func (c *Catalog[K, V]) Values() []V {
+	if c != nil {
+		return c.values
+	}
+
+	var no []V
+	return no
+}
+
+func (c *Catalog[K, V]) SetValues(values []V) {
+	if c != nil {
+		c.values = values
+	}
+}
+
+func (c *Catalog[K, V]) AddValues(items ...V) {
+	if c != nil {
+		c.values = append(c.values, items...)
+	}
+}
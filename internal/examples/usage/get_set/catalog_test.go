@@ -0,0 +1,28 @@
+package get_set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CatalogCollections(t *testing.T) {
+	catalog := &Catalog[string, int]{}
+	catalog.PutItems("a", 1)
+	catalog.PutItems("b", 2)
+	catalog.DeleteItems("a")
+	assert.Equal(t, map[string]int{"b": 2}, catalog.Items())
+
+	catalog.AddKeys("a", "b", "a")
+	catalog.RemoveKeys("a")
+	assert.Equal(t, []string{"b"}, catalog.Keys())
+
+	catalog.AddValues(1, 2)
+	assert.Equal(t, []int{1, 2}, catalog.Values())
+
+	catalog.AddTags("tag")
+	assert.Nil(t, catalog.Tags())
+	catalog.CatalogMeta = &CatalogMeta{}
+	catalog.AddTags("tag")
+	assert.Equal(t, []string{"tag"}, catalog.Tags())
+}
@@ -0,0 +1,16 @@
+package get_set
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+//go:generate fieldr -type Counter get-set -lock RWMutex -atomic -collections
+
+type Counter struct {
+	sync.RWMutex
+	name   string
+	labels map[string]string
+	hits   atomic.Int64
+	last   atomic.Pointer[string]
+}
@@ -0,0 +1,90 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+func (c *Counter) Name() string {
+	if c != nil {
+		c.RWMutex.RLock()
+		defer c.RWMutex.RUnlock()
+		return c.name
+	}
+
+	var no string
+	return no
+}
+
+func (c *Counter) SetName(name string) {
+	if c != nil {
+		c.RWMutex.Lock()
+		defer c.RWMutex.Unlock()
+		c.name = name
+	}
+}
+
+func (c *Counter) Labels() map[string]string {
+	if c != nil {
+		c.RWMutex.RLock()
+		defer c.RWMutex.RUnlock()
+		return c.labels
+	}
+
+	var no map[string]string
+	return no
+}
+
+func (c *Counter) SetLabels(labels map[string]string) {
+	if c != nil {
+		c.RWMutex.Lock()
+		defer c.RWMutex.Unlock()
+		c.labels = labels
+	}
+}
+
+func (c *Counter) PutLabels(key string, value string) {
+	if c != nil {
+		c.RWMutex.Lock()
+		defer c.RWMutex.Unlock()
+		if c.labels == nil {
+			c.labels = make(map[string]string)
+		}
+		c.labels[key] = value
+	}
+}
+
+func (c *Counter) DeleteLabels(key string) {
+	if c != nil {
+		c.RWMutex.Lock()
+		defer c.RWMutex.Unlock()
+		delete(c.labels, key)
+	}
+}
+
+func (c *Counter) Hits() int64 {
+	if c != nil {
+		return c.hits.Load()
+	}
+
+	var no int64
+	return no
+}
+
+func (c *Counter) SetHits(hits int64) {
+	if c != nil {
+		c.hits.Store(hits)
+	}
+}
+
+func (c *Counter) Last() *string {
+	if c != nil {
+		return c.last.Load()
+	}
+
+	var no *string
+	return no
+}
+
+func (c *Counter) SetLast(last *string) {
+	if c != nil {
+		c.last.Store(last)
+	}
+}
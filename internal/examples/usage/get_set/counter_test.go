@@ -0,0 +1,36 @@
+package get_set
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CounterLockedAccessors(t *testing.T) {
+	counter := &Counter{}
+	wg := sync.WaitGroup{}
+	stored := map[string]string{}
+	hits := []int64{}
+	for i := range 10 {
+		key := strconv.Itoa(i)
+		stored[key] = "value" + key
+		hits = append(hits, int64(i+1))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counter.SetName("counter")
+			counter.PutLabels(key, "value"+key)
+			counter.SetHits(int64(i + 1))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, "counter", counter.Name())
+	assert.Equal(t, stored, counter.Labels())
+	assert.Contains(t, hits, counter.Hits())
+
+	last := "last"
+	counter.SetLast(&last)
+	assert.Equal(t, &last, counter.Last())
+}
@@ -0,0 +1,15 @@
+package get_set
+
+//go:generate fieldr -type Node -out node_fieldr.go get-set -flat Next -flat Owner -flat Home
+
+// Node refers to itself, the flattening stops at the types already on the field path.
+type Node struct {
+	Val   int
+	Next  *Node
+	Owner *Member
+}
+
+type Member struct {
+	Name string
+	Home *Node
+}
@@ -0,0 +1,70 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+func (n *Node) GetVal() int {
+	if n != nil {
+		return n.Val
+	}
+
+	var no int
+	return no
+}
+
+func (n *Node) SetVal(val int) {
+	if n != nil {
+		n.Val = val
+	}
+}
+
+func (n *Node) GetNext() *Node {
+	if n != nil {
+		return n.Next
+	}
+
+	var no *Node
+	return no
+}
+
+func (n *Node) SetNext(next *Node) {
+	if n != nil {
+		n.Next = next
+	}
+}
+
+func (n *Node) GetOwner() *Member {
+	if n != nil {
+		return n.Owner
+	}
+
+	var no *Member
+	return no
+}
+
+func (n *Node) SetOwner(owner *Member) {
+	if n != nil {
+		n.Owner = owner
+	}
+}
+
+func (n *Node) GetOwnerName() string {
+	if n != nil {
+		if o := n.Owner; o != nil {
+			return o.Name
+		}
+	}
+
+	var no string
+	return no
+}
+
+func (n *Node) GetOwnerHome() *Node {
+	if n != nil {
+		if o := n.Owner; o != nil {
+			return o.Home
+		}
+	}
+
+	var no *Node
+	return no
+}
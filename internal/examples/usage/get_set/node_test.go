@@ -0,0 +1,19 @@
+package get_set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NodeFlatRecursive(t *testing.T) {
+	var node *Node
+	assert.Equal(t, "", node.GetOwnerName())
+	assert.Nil(t, node.GetOwnerHome())
+
+	home := &Node{Val: 1}
+	node = &Node{Val: 2, Next: home, Owner: &Member{Name: "owner", Home: home}}
+	assert.Equal(t, "owner", node.GetOwnerName())
+	assert.Same(t, home, node.GetOwnerHome())
+	assert.Same(t, home, node.GetNext())
+}
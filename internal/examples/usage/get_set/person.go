@@ -0,0 +1,18 @@
+package get_set
+
+//go:generate fieldr -type Person -out person_fieldr.go get-set -flat Address -flat Geo
+//go:generate fieldr -type Person -out person_ok_fieldr.go get-set -accessors get -get-prefix Lookup -flat Address -flat-ok
+
+type Person struct {
+	Name    string
+	Address *Address
+}
+
+type Address struct {
+	City string
+	Geo  *Geo
+}
+
+type Geo struct {
+	Lat, Lon float64
+}
@@ -0,0 +1,81 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+func (p *Person) GetName() string {
+	if p != nil {
+		return p.Name
+	}
+
+	var no string
+	return no
+}
+
+func (p *Person) SetName(name string) {
+	if p != nil {
+		p.Name = name
+	}
+}
+
+func (p *Person) GetAddress() *Address {
+	if p != nil {
+		return p.Address
+	}
+
+	var no *Address
+	return no
+}
+
+func (p *Person) SetAddress(address *Address) {
+	if p != nil {
+		p.Address = address
+	}
+}
+
+func (p *Person) GetAddressCity() string {
+	if p != nil {
+		if a := p.Address; a != nil {
+			return a.City
+		}
+	}
+
+	var no string
+	return no
+}
+
+func (p *Person) GetAddressGeo() *Geo {
+	if p != nil {
+		if a := p.Address; a != nil {
+			return a.Geo
+		}
+	}
+
+	var no *Geo
+	return no
+}
+
+func (p *Person) GetAddressGeoLat() float64 {
+	if p != nil {
+		if a := p.Address; a != nil {
+			if g := a.Geo; g != nil {
+				return g.Lat
+			}
+		}
+	}
+
+	var no float64
+	return no
+}
+
+func (p *Person) GetAddressGeoLon() float64 {
+	if p != nil {
+		if a := p.Address; a != nil {
+			if g := a.Geo; g != nil {
+				return g.Lon
+			}
+		}
+	}
+
+	var no float64
+	return no
+}
@@ -0,0 +1,43 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+func (p *Person) LookupName() string {
+	if p != nil {
+		return p.Name
+	}
+
+	var no string
+	return no
+}
+
+func (p *Person) LookupAddress() *Address {
+	if p != nil {
+		return p.Address
+	}
+
+	var no *Address
+	return no
+}
+
+func (p *Person) LookupAddressCity() (string, bool) {
+	if p != nil {
+		if a := p.Address; a != nil {
+			return a.City, true
+		}
+	}
+
+	var no string
+	return no, false
+}
+
+func (p *Person) LookupAddressGeo() (*Geo, bool) {
+	if p != nil {
+		if a := p.Address; a != nil {
+			return a.Geo, true
+		}
+	}
+
+	var no *Geo
+	return no, false
+}
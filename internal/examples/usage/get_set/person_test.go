@@ -0,0 +1,27 @@
+package get_set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PersonDeepGetters(t *testing.T) {
+	var person *Person
+	assert.Equal(t, "", person.GetAddressCity())
+	assert.Equal(t, float64(0), person.GetAddressGeoLat())
+
+	person = &Person{Address: &Address{City: "city"}}
+	assert.Equal(t, "city", person.GetAddressCity())
+	assert.Equal(t, float64(0), person.GetAddressGeoLon())
+
+	person.Address.Geo = &Geo{Lat: 1, Lon: 2}
+	assert.Equal(t, float64(2), person.GetAddressGeoLon())
+
+	city, ok := person.LookupAddressCity()
+	assert.True(t, ok)
+	assert.Equal(t, "city", city)
+
+	_, ok = (&Person{}).LookupAddressCity()
+	assert.False(t, ok)
+}
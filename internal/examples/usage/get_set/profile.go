@@ -0,0 +1,15 @@
+package get_set
+
+//go:generate fieldr -type Profile get-set -track dirty -collections
+
+type Profile struct {
+	*Audit
+	name  string
+	email string
+	tags  []string
+	dirty map[string]struct{}
+}
+
+type Audit struct {
+	Version int
+}
@@ -0,0 +1,150 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+import "slices"
+
+type ProfileField string
+
+const (
+	ProfileAuditVersion ProfileField = "Version"
+	ProfileName         ProfileField = "name"
+	ProfileEmail        ProfileField = "email"
+	ProfileTags         ProfileField = "tags"
+)
+
+func (p *Profile) Dirty() []ProfileField {
+	if p != nil {
+		if len(p.dirty) == 0 {
+			return nil
+		}
+		fields := make([]ProfileField, 0, len(p.dirty))
+		for _, f := range [...]ProfileField{ProfileAuditVersion, ProfileName, ProfileEmail, ProfileTags} {
+			if _, ok := p.dirty[string(f)]; ok {
+				fields = append(fields, f)
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
+func (p *Profile) IsDirty(f ProfileField) bool {
+	if p != nil {
+		_, ok := p.dirty[string(f)]
+		return ok
+	}
+	return false
+}
+
+func (p *Profile) ResetDirty() {
+	if p != nil {
+		p.dirty = nil
+	}
+}
+
+func (p *Profile) GetVersion() int {
+	if p != nil {
+		if a := p.Audit; a != nil {
+			return a.Version
+		}
+	}
+
+	var no int
+	return no
+}
+
+func (p *Profile) SetVersion(version int) {
+	if p != nil {
+		if a := p.Audit; a != nil {
+			if a.Version != version {
+				a.Version = version
+				if p.dirty == nil {
+					p.dirty = make(map[string]struct{})
+				}
+				p.dirty[string(ProfileAuditVersion)] = struct{}{}
+			}
+		}
+	}
+}
+
+func (p *Profile) Name() string {
+	if p != nil {
+		return p.name
+	}
+
+	var no string
+	return no
+}
+
+func (p *Profile) SetName(name string) {
+	if p != nil {
+		if p.name != name {
+			p.name = name
+			if p.dirty == nil {
+				p.dirty = make(map[string]struct{})
+			}
+			p.dirty[string(ProfileName)] = struct{}{}
+		}
+	}
+}
+
+func (p *Profile) Email() string {
+	if p != nil {
+		return p.email
+	}
+
+	var no string
+	return no
+}
+
+func (p *Profile) SetEmail(email string) {
+	if p != nil {
+		if p.email != email {
+			p.email = email
+			if p.dirty == nil {
+				p.dirty = make(map[string]struct{})
+			}
+			p.dirty[string(ProfileEmail)] = struct{}{}
+		}
+	}
+}
+
+func (p *Profile) Tags() []string {
+	if p != nil {
+		return p.tags
+	}
+
+	var no []string
+	return no
+}
+
+func (p *Profile) SetTags(tags []string) {
+	if p != nil {
+		p.tags = tags
+		if p.dirty == nil {
+			p.dirty = make(map[string]struct{})
+		}
+		p.dirty[string(ProfileTags)] = struct{}{}
+	}
+}
+
+func (p *Profile) AddTags(items ...string) {
+	if p != nil {
+		p.tags = append(p.tags, items...)
+		if p.dirty == nil {
+			p.dirty = make(map[string]struct{})
+		}
+		p.dirty[string(ProfileTags)] = struct{}{}
+	}
+}
+
+func (p *Profile) RemoveTags(item string) {
+	if p != nil {
+		p.tags = slices.DeleteFunc(p.tags, func(e string) bool { return e == item })
+		if p.dirty == nil {
+			p.dirty = make(map[string]struct{})
+		}
+		p.dirty[string(ProfileTags)] = struct{}{}
+	}
+}
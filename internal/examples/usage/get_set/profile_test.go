@@ -0,0 +1,37 @@
+package get_set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ProfileDirtyFields(t *testing.T) {
+	profile := &Profile{Audit: &Audit{}, name: "name"}
+	assert.Empty(t, profile.Dirty())
+
+	profile.SetName("name")
+	assert.False(t, profile.IsDirty(ProfileName))
+
+	profile.SetEmail("name@example.com")
+	profile.AddTags("admin")
+	profile.SetVersion(1)
+	assert.True(t, profile.IsDirty(ProfileEmail))
+	assert.Equal(t, []ProfileField{ProfileAuditVersion, ProfileEmail, ProfileTags}, profile.Dirty())
+
+	profile.ResetDirty()
+	assert.Empty(t, profile.Dirty())
+	assert.Equal(t, "name@example.com", profile.Email())
+	assert.Equal(t, []string{"admin"}, profile.Tags())
+
+	var nilProfile *Profile
+	assert.Nil(t, nilProfile.Dirty())
+	assert.False(t, nilProfile.IsDirty(ProfileName))
+}
+
+func Test_SettingsDirtyFields(t *testing.T) {
+	settings := &Settings{}
+	settings.SetName("dark")
+	assert.True(t, settings.IsDirty(SettingsName))
+	assert.Equal(t, []SettingsField{SettingsName}, settings.Dirty())
+}
@@ -0,0 +1,9 @@
+package get_set
+
+//go:generate fieldr -type Settings get-set -track changed
+
+// Settings is tracked in the same package as Profile, the constants are prefixed by the type name.
+type Settings struct {
+	name    string
+	changed map[string]bool
+}
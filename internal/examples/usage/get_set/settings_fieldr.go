@@ -0,0 +1,58 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package get_set
+
+type SettingsField string
+
+const SettingsName SettingsField = "name"
+
+func (s *Settings) Dirty() []SettingsField {
+	if s != nil {
+		if len(s.changed) == 0 {
+			return nil
+		}
+		fields := make([]SettingsField, 0, len(s.changed))
+		for _, f := range [...]SettingsField{SettingsName} {
+			if _, ok := s.changed[string(f)]; ok {
+				fields = append(fields, f)
+			}
+		}
+		return fields
+	}
+	return nil
+}
+
+func (s *Settings) IsDirty(f SettingsField) bool {
+	if s != nil {
+		_, ok := s.changed[string(f)]
+		return ok
+	}
+	return false
+}
+
+func (s *Settings) ResetDirty() {
+	if s != nil {
+		s.changed = nil
+	}
+}
+
+func (s *Settings) Name() string {
+	if s != nil {
+		return s.name
+	}
+
+	var no string
+	return no
+}
+
+func (s *Settings) SetName(name string) {
+	if s != nil {
+		if s.name != name {
+			s.name = name
+			if s.changed == nil {
+				s.changed = make(map[string]bool)
+			}
+			s.changed[string(SettingsName)] = true
+		}
+	}
+}
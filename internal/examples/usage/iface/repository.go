@@ -0,0 +1,64 @@
+package iface
+
+import (
+	"context"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+	"time"
+
+	"example/sql_base"
+)
+
+//go:generate fieldr -type Repository interface -exclude ^Close$
+
+type Stats struct {
+	hits int
+}
+
+func (s Stats) Hits() int { return s.hits }
+
+type Repository[ID comparable] struct {
+	Stats
+	items map[ID]time.Time
+}
+
+func (r *Repository[ID]) Versioned(id ID) sql_base.VersionedEntity {
+	return sql_base.VersionedEntity{}
+}
+
+func (r *Repository[ID]) Get(_ context.Context, id ID) (time.Time, bool) {
+	ts, ok := r.items[id]
+	return ts, ok
+}
+
+func (r *Repository[ID]) Put(_ context.Context, id ID, ts time.Time) {
+	if r.items == nil {
+		r.items = map[ID]time.Time{}
+	}
+	r.items[id] = ts
+}
+
+func (r *Repository[ID]) Delete(ids ...ID) (count int) {
+	for _, id := range ids {
+		if _, ok := r.items[id]; ok {
+			delete(r.items, id)
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Repository[ID]) Render(out io.Writer, view struct {
+	Text *template.Template
+	HTML *htmltemplate.Template
+}) error {
+	if err := view.Text.Execute(out, r.items); err != nil {
+		return err
+	}
+	return view.HTML.Execute(out, r.items)
+}
+
+func (r *Repository[ID]) Close() error { return nil }
+
+func (r *Repository[ID]) lock() {}
@@ -0,0 +1,24 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package iface
+
+import (
+	"context"
+	"example/sql_base"
+	template1 "html/template"
+	"io"
+	"text/template"
+	"time"
+)
+
+type RepositoryAPI[ID comparable] interface {
+	Delete(ids ...ID) (count int)
+	Get(_ context.Context, id ID) (time.Time, bool)
+	Hits() int
+	Put(_ context.Context, id ID, ts time.Time)
+	Render(out io.Writer, view struct {
+		Text *template.Template
+		HTML *template1.Template
+	}) error
+	Versioned(id ID) sql_base.VersionedEntity
+}
@@ -0,0 +1,26 @@
+package iface
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ RepositoryAPI[string] = (*Repository[string])(nil)
+
+func Test_RepositoryAPI(t *testing.T) {
+	var (
+		repo RepositoryAPI[int] = &Repository[int]{}
+		ctx                     = context.Background()
+		ts                      = time.Now()
+	)
+
+	repo.Put(ctx, 1, ts)
+	got, ok := repo.Get(ctx, 1)
+	assert.True(t, ok)
+	assert.Equal(t, ts, got)
+	assert.Equal(t, 1, repo.Delete(1, 2))
+	assert.Equal(t, 0, repo.Hits())
+}
@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"context"
+	htmltemplate "html/template"
+	"io"
+	"text/template"
+	"time"
+)
+
+//go:generate fieldr -type Store mock
+
+type Store[ID comparable] interface {
+	io.Closer
+	Get(ctx context.Context, id ID) (time.Time, error)
+	Put(ctx context.Context, id ID, ts time.Time) error
+	Delete(ids ...ID) int
+	Find(string, int) []ID
+	Render(out io.Writer, view struct {
+		Text *template.Template
+		HTML *htmltemplate.Template
+	}) error
+}
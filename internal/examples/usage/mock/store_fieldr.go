@@ -0,0 +1,198 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	template1 "html/template"
+	"io"
+	"sync"
+	"text/template"
+	"time"
+)
+
+type StoreMock[ID comparable] struct {
+	CloseFunc  func() error
+	DeleteFunc func(ids ...ID) int
+	FindFunc   func(arg0 string, arg1 int) []ID
+	GetFunc    func(ctx context.Context, id ID) (time.Time, error)
+	PutFunc    func(ctx context.Context, id ID, ts time.Time) error
+	RenderFunc func(out io.Writer, view struct {
+		Text *template.Template
+		HTML *template1.Template
+	}) error
+	mu    sync.Mutex
+	calls struct {
+		Close  []StoreMockCloseCall[ID]
+		Delete []StoreMockDeleteCall[ID]
+		Find   []StoreMockFindCall[ID]
+		Get    []StoreMockGetCall[ID]
+		Put    []StoreMockPutCall[ID]
+		Render []StoreMockRenderCall[ID]
+	}
+}
+
+type StoreMockCloseCall[ID comparable] struct{}
+
+type StoreMockDeleteCall[ID comparable] struct {
+	IDs []ID
+}
+
+type StoreMockFindCall[ID comparable] struct {
+	Arg0 string
+	Arg1 int
+}
+
+type StoreMockGetCall[ID comparable] struct {
+	Ctx context.Context
+	ID  ID
+}
+
+type StoreMockPutCall[ID comparable] struct {
+	Ctx context.Context
+	ID  ID
+	Ts  time.Time
+}
+
+type StoreMockRenderCall[ID comparable] struct {
+	Out  io.Writer
+	View struct {
+		Text *template.Template
+		HTML *template1.Template
+	}
+}
+
+func (m *StoreMock[ID]) Close() error {
+	m.mu.Lock()
+	m.calls.Close = append(m.calls.Close, StoreMockCloseCall[ID]{})
+	m.mu.Unlock()
+	if m.CloseFunc == nil {
+		panic("StoreMock.Close: CloseFunc is not set")
+	}
+	return m.CloseFunc()
+}
+
+func (m *StoreMock[ID]) CloseCalls() []StoreMockCloseCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockCloseCall[ID](nil), m.calls.Close...)
+}
+
+func (m *StoreMock[ID]) CloseCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Close)
+}
+
+func (m *StoreMock[ID]) Delete(ids ...ID) int {
+	m.mu.Lock()
+	m.calls.Delete = append(m.calls.Delete, StoreMockDeleteCall[ID]{IDs: ids})
+	m.mu.Unlock()
+	if m.DeleteFunc == nil {
+		panic("StoreMock.Delete: DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ids...)
+}
+
+func (m *StoreMock[ID]) DeleteCalls() []StoreMockDeleteCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockDeleteCall[ID](nil), m.calls.Delete...)
+}
+
+func (m *StoreMock[ID]) DeleteCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Delete)
+}
+
+func (m *StoreMock[ID]) Find(arg0 string, arg1 int) []ID {
+	m.mu.Lock()
+	m.calls.Find = append(m.calls.Find, StoreMockFindCall[ID]{Arg0: arg0, Arg1: arg1})
+	m.mu.Unlock()
+	if m.FindFunc == nil {
+		panic("StoreMock.Find: FindFunc is not set")
+	}
+	return m.FindFunc(arg0, arg1)
+}
+
+func (m *StoreMock[ID]) FindCalls() []StoreMockFindCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockFindCall[ID](nil), m.calls.Find...)
+}
+
+func (m *StoreMock[ID]) FindCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Find)
+}
+
+func (m *StoreMock[ID]) Get(ctx context.Context, id ID) (time.Time, error) {
+	m.mu.Lock()
+	m.calls.Get = append(m.calls.Get, StoreMockGetCall[ID]{Ctx: ctx, ID: id})
+	m.mu.Unlock()
+	if m.GetFunc == nil {
+		panic("StoreMock.Get: GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+func (m *StoreMock[ID]) GetCalls() []StoreMockGetCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockGetCall[ID](nil), m.calls.Get...)
+}
+
+func (m *StoreMock[ID]) GetCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Get)
+}
+
+func (m *StoreMock[ID]) Put(ctx context.Context, id ID, ts time.Time) error {
+	m.mu.Lock()
+	m.calls.Put = append(m.calls.Put, StoreMockPutCall[ID]{Ctx: ctx, ID: id, Ts: ts})
+	m.mu.Unlock()
+	if m.PutFunc == nil {
+		panic("StoreMock.Put: PutFunc is not set")
+	}
+	return m.PutFunc(ctx, id, ts)
+}
+
+func (m *StoreMock[ID]) PutCalls() []StoreMockPutCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockPutCall[ID](nil), m.calls.Put...)
+}
+
+func (m *StoreMock[ID]) PutCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Put)
+}
+
+func (m *StoreMock[ID]) Render(out io.Writer, view struct {
+	Text *template.Template
+	HTML *template1.Template
+}) error {
+	m.mu.Lock()
+	m.calls.Render = append(m.calls.Render, StoreMockRenderCall[ID]{Out: out, View: view})
+	m.mu.Unlock()
+	if m.RenderFunc == nil {
+		panic("StoreMock.Render: RenderFunc is not set")
+	}
+	return m.RenderFunc(out, view)
+}
+
+func (m *StoreMock[ID]) RenderCalls() []StoreMockRenderCall[ID] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]StoreMockRenderCall[ID](nil), m.calls.Render...)
+}
+
+func (m *StoreMock[ID]) RenderCallCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.calls.Render)
+}
@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var _ Store[string] = (*StoreMock[string])(nil)
+
+func Test_StoreMock(t *testing.T) {
+	ts := time.Now()
+	store := &StoreMock[int]{
+		GetFunc: func(ctx context.Context, id int) (time.Time, error) {
+			return ts, nil
+		},
+		DeleteFunc: func(ids ...int) int { return len(ids) },
+	}
+	ctx := context.Background()
+
+	got, err := store.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, ts, got)
+	assert.Equal(t, []StoreMockGetCall[int]{{Ctx: ctx, ID: 1}}, store.GetCalls())
+
+	assert.Equal(t, 2, store.Delete(1, 2))
+	assert.Equal(t, []StoreMockDeleteCall[int]{{IDs: []int{1, 2}}}, store.DeleteCalls())
+
+	assert.PanicsWithValue(t, "StoreMock.Put: PutFunc is not set", func() { _ = store.Put(ctx, 1, ts) })
+	assert.Equal(t, 1, store.PutCallCount())
+	assert.Equal(t, 0, store.FindCallCount())
+}
+
+func Test_StoreMockConcurrentCalls(t *testing.T) {
+	store := &StoreMock[int]{CloseFunc: func() error { return nil }}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = store.Close()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 10, store.CloseCallCount())
+	assert.Len(t, store.CloseCalls(), 10)
+}
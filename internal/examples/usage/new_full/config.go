@@ -0,0 +1,10 @@
+package new_full
+
+const DefaultPort = 8080
+
+//go:generate fieldr -type Config new-full -exclude Port -exclude Debug -default Debug=false
+type Config struct {
+	Host  string
+	Port  int `default:"DefaultPort"`
+	Debug bool
+}
@@ -0,0 +1,11 @@
+package new_full
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConfigDefaults(t *testing.T) {
+	assert.Equal(t, &Config{Host: "localhost", Port: DefaultPort}, NewConfig("localhost"))
+}
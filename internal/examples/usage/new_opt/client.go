@@ -0,0 +1,12 @@
+package new_opt
+
+//go:generate fieldr -type Client new-opt -option-type . -flat -required Addr -suffix ClientWith
+type Client[K comparable] struct {
+	*Settings
+	Addr string
+	Keys []K
+}
+
+type Settings struct {
+	Retries int
+}
@@ -0,0 +1,60 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package new_opt
+
+import "fmt"
+
+type ClientOption[K comparable] interface {
+	apply(*Client[K])
+}
+
+type clientWithRetriesOption[K comparable] struct {
+	value int
+}
+
+type clientWithKeysOption[K comparable] struct {
+	value []K
+}
+
+func NewClient[K comparable](
+	addr string,
+	opts ...ClientOption[K],
+) *Client[K] {
+	r := &Client[K]{
+		Settings: &Settings{},
+		Addr:     addr,
+	}
+	for _, opt := range opts {
+		opt.apply(r)
+	}
+	return r
+}
+
+func ClientWithRetries[K comparable](retries int) ClientOption[K] {
+	return clientWithRetriesOption[K]{value: retries}
+}
+
+func ClientWithKeys[K comparable](keys []K) ClientOption[K] {
+	return clientWithKeysOption[K]{value: keys}
+}
+
+func (o clientWithRetriesOption[K]) apply(c *Client[K]) {
+	s := c.Settings
+	if s == nil {
+		s = new(Settings)
+		c.Settings = s
+	}
+	s.Retries = o.value
+}
+
+func (o clientWithRetriesOption[K]) String() string {
+	return fmt.Sprintf("ClientWithRetries(%v)", o.value)
+}
+
+func (o clientWithKeysOption[K]) apply(c *Client[K]) {
+	c.Keys = o.value
+}
+
+func (o clientWithKeysOption[K]) String() string {
+	return fmt.Sprintf("ClientWithKeys(%v)", o.value)
+}
@@ -0,0 +1,16 @@
+package new_opt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ClientOptionType(t *testing.T) {
+	client := NewClient("localhost", ClientWithRetries[string](3), ClientWithKeys([]string{"a"}))
+	assert.Equal(t, &Client[string]{Settings: &Settings{Retries: 3}, Addr: "localhost", Keys: []string{"a"}}, client)
+
+	assert.Equal(t, "ClientWithRetries(3)", fmt.Sprint(ClientWithRetries[string](3)))
+	assert.Equal(t, ClientWithRetries[string](3), ClientWithRetries[string](3))
+}
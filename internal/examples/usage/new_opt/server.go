@@ -0,0 +1,16 @@
+package new_opt
+
+import "time"
+
+const (
+	DefaultRetries = 3
+	DefaultTag     = "default"
+)
+
+//go:generate fieldr -type Server new-opt -required Addr -default "Timeout=30 * time.Second"
+type Server struct {
+	Addr    string
+	Timeout time.Duration
+	Retries int      `default:"DefaultRetries"`
+	Tags    []string `default:"[]string{DefaultTag}"`
+}
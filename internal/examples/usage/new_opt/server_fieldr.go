@@ -0,0 +1,39 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package new_opt
+
+import "time"
+
+func NewServer(
+	addr string,
+	opts ...func(*Server),
+) *Server {
+	r := &Server{
+		Addr:    addr,
+		Timeout: 30 * time.Second,
+		Retries: DefaultRetries,
+		Tags:    []string{DefaultTag},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func WithTimeout(timeout time.Duration) func(s *Server) {
+	return func(s *Server) {
+		s.Timeout = timeout
+	}
+}
+
+func WithRetries(retries int) func(s *Server) {
+	return func(s *Server) {
+		s.Retries = retries
+	}
+}
+
+func WithTags(tags []string) func(s *Server) {
+	return func(s *Server) {
+		s.Tags = tags
+	}
+}
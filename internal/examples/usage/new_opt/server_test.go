@@ -0,0 +1,16 @@
+package new_opt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ServerDefaults(t *testing.T) {
+	server := NewServer("localhost")
+	assert.Equal(t, &Server{Addr: "localhost", Timeout: 30 * time.Second, Retries: DefaultRetries, Tags: []string{DefaultTag}}, server)
+
+	server = NewServer("localhost", WithTimeout(time.Second), WithRetries(0))
+	assert.Equal(t, &Server{Addr: "localhost", Timeout: time.Second, Tags: []string{DefaultTag}}, server)
+}
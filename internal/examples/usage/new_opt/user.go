@@ -0,0 +1,27 @@
+package new_opt
+
+import (
+	"errors"
+	"strings"
+)
+
+//go:generate fieldr -type User new-opt -error -required Login -validate Email=validateEmail -validate Age=validateAge -suffix UserWith
+type User struct {
+	Login string
+	Email string
+	Age   int
+}
+
+func validateEmail(email string) error {
+	if !strings.Contains(email, "@") {
+		return errors.New("invalid email")
+	}
+	return nil
+}
+
+func validateAge(age int) error {
+	if age < 0 {
+		return errors.New("negative age")
+	}
+	return nil
+}
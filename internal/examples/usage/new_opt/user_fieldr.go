@@ -0,0 +1,38 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package new_opt
+
+func NewUser(
+	login string,
+	opts ...func(*User) error,
+) (*User, error) {
+	r := &User{
+		Login: login,
+	}
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func UserWithEmail(email string) func(u *User) error {
+	return func(u *User) error {
+		if err := validateEmail(email); err != nil {
+			return err
+		}
+		u.Email = email
+		return nil
+	}
+}
+
+func UserWithAge(age int) func(u *User) error {
+	return func(u *User) error {
+		if err := validateAge(age); err != nil {
+			return err
+		}
+		u.Age = age
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package new_opt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UserOptionsValidation(t *testing.T) {
+	user, err := NewUser("login", UserWithEmail("user@example.com"), UserWithAge(18))
+	require.NoError(t, err)
+	assert.Equal(t, &User{Login: "login", Email: "user@example.com", Age: 18}, user)
+
+	_, err = NewUser("login", UserWithEmail("example.com"))
+	assert.EqualError(t, err, "invalid email")
+
+	_, err = NewUser("login", UserWithAge(-1))
+	assert.EqualError(t, err, "negative age")
+}
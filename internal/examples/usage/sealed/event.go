@@ -0,0 +1,21 @@
+package sealed
+
+//go:generate fieldr -type Event -out event_fieldr.go sealed -variant Created -variant Deleted
+
+// Event declares the marker method only, so the variants are listed explicitly.
+type Event interface {
+	isEvent()
+}
+
+type Created struct {
+	ID string `json:"id"`
+}
+
+type Deleted struct {
+	ID string `json:"id"`
+}
+
+// Config is not a variant of Event.
+type Config struct {
+	Debug bool
+}
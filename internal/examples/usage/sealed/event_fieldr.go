@@ -0,0 +1,91 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package sealed
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (Created) isEvent() {}
+
+func (Deleted) isEvent() {}
+
+func visitEvent[R any](v Event, created func(Created) R, deleted func(Deleted) R) (r R) {
+	switch vt := v.(type) {
+	case Created:
+		if created != nil {
+			return created(vt)
+		}
+	case *Created:
+		if created != nil && vt != nil {
+			return created(*vt)
+		}
+	case Deleted:
+		if deleted != nil {
+			return deleted(vt)
+		}
+	case *Deleted:
+		if deleted != nil && vt != nil {
+			return deleted(*vt)
+		}
+	}
+	return r
+}
+
+func marshalEventJSON(v Event) ([]byte, error) {
+	var discriminator string
+	switch v.(type) {
+	case nil:
+		return []byte("null"), nil
+	case Created, *Created:
+		discriminator = "Created"
+	case Deleted, *Deleted:
+		discriminator = "Deleted"
+	default:
+		return nil, fmt.Errorf("unexpected variant %T of Event", v)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != '{' {
+		return nil, fmt.Errorf("variant %T of Event is not marshaled to a JSON object", v)
+	}
+	head := `{"type":"` + discriminator + `"`
+	if len(data) > 2 {
+		head += ","
+	}
+	return append([]byte(head), data[1:]...), nil
+}
+
+func unmarshalEventJSON(data []byte) (Event, error) {
+	var discriminator *struct {
+		Value *string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	if discriminator == nil {
+		return nil, nil
+	}
+	if discriminator.Value == nil {
+		return nil, fmt.Errorf("no discriminator 'type' of Event")
+	}
+	switch *discriminator.Value {
+	case "Created":
+		var v Created
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "Deleted":
+		var v Deleted
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unexpected discriminator %q of Event", *discriminator.Value)
+	}
+}
@@ -0,0 +1,34 @@
+package sealed
+
+//go:generate fieldr -type Shape sealed -export
+
+type Shape interface {
+	Area() float64
+	isShape()
+}
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+func (c Circle) Area() float64 { return 3 * c.Radius * c.Radius }
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+func (s *Square) Area() float64 { return s.Side * s.Side }
+
+type Triangle struct {
+	Base   float64 `json:"base"`
+	Height float64 `json:"height"`
+}
+
+func (t Triangle) Area() float64 { return t.Base * t.Height / 2 }
+
+// isShape is declared by hand, the generator doesn't redeclare it.
+func (Triangle) isShape() {}
+
+type Point struct{}
+
+func (p Point) X() float64 { return 0 }
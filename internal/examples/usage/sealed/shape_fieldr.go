@@ -0,0 +1,103 @@
+// Code generated by 'fieldr'; DO NOT EDIT.
+
+package sealed
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (Circle) isShape() {}
+
+func (*Square) isShape() {}
+
+func VisitShape[R any](v Shape, circle func(Circle) R, square func(*Square) R, triangle func(Triangle) R) (r R) {
+	switch vt := v.(type) {
+	case Circle:
+		if circle != nil {
+			return circle(vt)
+		}
+	case *Circle:
+		if circle != nil && vt != nil {
+			return circle(*vt)
+		}
+	case *Square:
+		if square != nil {
+			return square(vt)
+		}
+	case Triangle:
+		if triangle != nil {
+			return triangle(vt)
+		}
+	case *Triangle:
+		if triangle != nil && vt != nil {
+			return triangle(*vt)
+		}
+	}
+	return r
+}
+
+func MarshalShapeJSON(v Shape) ([]byte, error) {
+	var discriminator string
+	switch v.(type) {
+	case nil:
+		return []byte("null"), nil
+	case Circle, *Circle:
+		discriminator = "Circle"
+	case *Square:
+		discriminator = "Square"
+	case Triangle, *Triangle:
+		discriminator = "Triangle"
+	default:
+		return nil, fmt.Errorf("unexpected variant %T of Shape", v)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != '{' {
+		return nil, fmt.Errorf("variant %T of Shape is not marshaled to a JSON object", v)
+	}
+	head := `{"type":"` + discriminator + `"`
+	if len(data) > 2 {
+		head += ","
+	}
+	return append([]byte(head), data[1:]...), nil
+}
+
+func UnmarshalShapeJSON(data []byte) (Shape, error) {
+	var discriminator *struct {
+		Value *string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+	if discriminator == nil {
+		return nil, nil
+	}
+	if discriminator.Value == nil {
+		return nil, fmt.Errorf("no discriminator 'type' of Shape")
+	}
+	switch *discriminator.Value {
+	case "Circle":
+		var v Circle
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "Square":
+		v := new(Square)
+		if err := json.Unmarshal(data, v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	case "Triangle":
+		var v Triangle
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, fmt.Errorf("unexpected discriminator %q of Shape", *discriminator.Value)
+	}
+}
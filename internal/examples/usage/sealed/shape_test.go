@@ -0,0 +1,72 @@
+package sealed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VisitShape(t *testing.T) {
+	name := func(s Shape) string {
+		return VisitShape(s, func(Circle) string { return "circle" }, func(*Square) string { return "square" }, func(Triangle) string { return "triangle" })
+	}
+
+	assert.Equal(t, "circle", name(Circle{Radius: 1}))
+	assert.Equal(t, "circle", name(&Circle{Radius: 1}))
+	assert.Equal(t, "square", name(&Square{Side: 1}))
+	assert.Equal(t, "triangle", name(Triangle{Base: 1, Height: 1}))
+	assert.Equal(t, "", name(nil))
+	assert.Equal(t, 0, VisitShape[int](Circle{}, nil, nil, nil))
+}
+
+func Test_ShapeJSON(t *testing.T) {
+	data, err := MarshalShapeJSON(Circle{Radius: 2})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Circle","radius":2}`, string(data))
+
+	shape, err := UnmarshalShapeJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, Circle{Radius: 2}, shape)
+
+	data, err = MarshalShapeJSON(&Square{Side: 3})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Square","side":3}`, string(data))
+
+	shape, err = UnmarshalShapeJSON(data)
+	require.NoError(t, err)
+	assert.Equal(t, &Square{Side: 3}, shape)
+
+	data, err = MarshalShapeJSON(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	shape, err = UnmarshalShapeJSON(data)
+	require.NoError(t, err)
+	assert.Nil(t, shape)
+
+	_, err = UnmarshalShapeJSON([]byte(`{"radius":2}`))
+	assert.Error(t, err)
+
+	shape, err = UnmarshalShapeJSON([]byte(`{"type":"Triangle","base":2,"height":3}`))
+	require.NoError(t, err)
+	assert.Equal(t, Triangle{Base: 2, Height: 3}, shape)
+
+	_, err = UnmarshalShapeJSON([]byte(`{"type":"Hexagon"}`))
+	assert.Error(t, err)
+}
+
+func Test_EventVariants(t *testing.T) {
+	name := func(e Event) string {
+		return visitEvent(e, func(Created) string { return "created" }, func(Deleted) string { return "deleted" })
+	}
+	assert.Equal(t, "created", name(Created{ID: "1"}))
+	assert.Equal(t, "deleted", name(Deleted{ID: "1"}))
+
+	data, err := marshalEventJSON(Deleted{ID: "1"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"Deleted","id":"1"}`, string(data))
+
+	_, err = unmarshalEventJSON([]byte(`{"type":"Config"}`))
+	assert.Error(t, err)
+}
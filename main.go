@@ -292,14 +292,14 @@ func run() error {
 		pkgTypes := outPkg.Types
 		pkgPath := outPkg.PkgPath
 
-		g, err := generator.New(fileSet, params.Name, typeConfig.OutBuildTags, outFile, outFileInfo, pkgPath, pkgTypes)
+		g, err := generator.New(fileSet, params.Name, typeConfig.OutBuildTags, *buildTags, outFile, outFileInfo, pkgPath, pkgTypes)
 		if err != nil {
 			return err
 		}
 		o := typ.Obj()
 		pp := o.Pkg()
 		_ = pp
-		ctx := &command.Context{Generator: g, Typ: typ, TypFile: typFile}
+		ctx := &command.Context{Generator: g, Typ: typ, TypFile: typFile, FileSet: fileSet, Packages: pkgs}
 		for _, c := range commands {
 			logger.Debugf("run command %s", c.Name())
 			if err := c.Run(ctx); err != nil {
@@ -0,0 +1,114 @@
+package iface
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/m4gshm/gollections/collection/immutable"
+	"github.com/m4gshm/gollections/slice"
+
+	"github.com/m4gshm/fieldr/model/util"
+)
+
+// Model interface type model.
+type Model struct {
+	typ   util.TypeNamedOrAlias
+	iface *types.Interface
+}
+
+// Variant is a type that implements the interface.
+type Variant struct {
+	Typ util.TypeNamedOrAlias
+	// Ref means the interface is implemented by the pointer of the type only.
+	Ref bool
+}
+
+// New - Model's default constructor.
+func New(typ util.TypeNamedOrAlias) (*Model, error) {
+	typName := typ.Obj().Name()
+	iface, _ := typ.Underlying().(*types.Interface)
+	if iface == nil {
+		return nil, fmt.Errorf("'%s' is not an interface", typName)
+	} else if !iface.IsMethodSet() {
+		return nil, fmt.Errorf("'%s' is a constraint interface", typName)
+	}
+	return &Model{typ: typ, iface: iface}, nil
+}
+
+func (m *Model) Typ() util.TypeNamedOrAlias {
+	return m.typ
+}
+
+func (m *Model) Interface() *types.Interface {
+	return m.iface
+}
+
+func (m *Model) TypeName() string {
+	return m.typ.Obj().Name()
+}
+
+func (m *Model) Package() *types.Package {
+	return m.typ.Obj().Pkg()
+}
+
+// Methods returns all methods of the interface including the embedded ones.
+func (m *Model) Methods() []*types.Func {
+	return slice.OfIndexed(m.iface.NumMethods(), m.iface.Method)
+}
+
+// RequiredMethods returns the interface methods except the ignored ones.
+func (m *Model) RequiredMethods(ignored ...string) []*types.Func {
+	isIgnored := immutable.NewSet(ignored...).Contains
+	return slice.Filter(m.Methods(), func(method *types.Func) bool { return !isIgnored(method.Name()) })
+}
+
+// Implements checks whether the type or the pointer of the type implements the interface; the ignored methods are not required.
+func (m *Model) Implements(typ types.Type, ignored ...string) (Variant, bool) {
+	named, _ := typ.(util.TypeNamedOrAlias)
+	if named == nil || types.IsInterface(typ) {
+		return Variant{}, false
+	}
+	required := types.NewInterfaceType(m.RequiredMethods(ignored...), nil).Complete()
+	if types.Implements(typ, required) {
+		return Variant{Typ: named}, true
+	} else if types.Implements(types.NewPointer(typ), required) {
+		return Variant{Typ: named, Ref: true}, true
+	}
+	return Variant{}, false
+}
+
+// Implementations returns the non-generic struct types of the scope that implement the interface; the ignored methods are not required.
+func (m *Model) Implementations(scope *types.Scope, ignored ...string) []Variant {
+	variants := []Variant{}
+	for _, name := range scope.Names() {
+		typName, _ := scope.Lookup(name).(*types.TypeName)
+		if typName == nil || typName.IsAlias() || typName == m.typ.Obj() {
+			continue
+		} else if named, _ := typName.Type().(*types.Named); named == nil || named.TypeParams().Len() > 0 {
+			continue
+		} else if _, ok := named.Underlying().(*types.Struct); !ok {
+			continue
+		} else if variant, ok := m.Implements(typName.Type(), ignored...); ok {
+			variants = append(variants, variant)
+		}
+	}
+	return variants
+}
+
+// TypeMethods returns the method set of the type or the pointer of the type, methods of a generic type are instantiated by its own type parameters.
+func TypeMethods(typ util.TypeNamedOrAlias, ref bool) ([]*types.Func, error) {
+	var instance types.Type = typ
+	if tparams := typ.TypeParams(); tparams.Len() > 0 && typ.TypeArgs().Len() == 0 {
+		args := slice.Convert(slice.OfIndexed(tparams.Len(), tparams.At), func(tp *types.TypeParam) types.Type { return tp })
+		inst, err := types.Instantiate(nil, typ, args, false)
+		if err != nil {
+			return nil, fmt.Errorf("instantiate %s: %w", typ.Obj().Name(), err)
+		}
+		instance = inst
+	}
+	if ref && !types.IsInterface(instance) {
+		instance = types.NewPointer(instance)
+	}
+	methodSet := types.NewMethodSet(instance)
+	return slice.OfIndexed(methodSet.Len(), func(i int) *types.Func { return methodSet.At(i).Obj().(*types.Func) }), nil
+}
@@ -6,6 +6,8 @@ import (
 	"go/token"
 	"go/types"
 	"reflect"
+	"slices"
+	"strings"
 
 	"github.com/m4gshm/gollections/convert/as"
 	"github.com/m4gshm/gollections/map_"
@@ -31,6 +33,7 @@ func NewModel(outPkgPath string, loopControl HandledStructs, typ util.TypeNamedO
 		TagsFieldValue: map[TagName]map[FieldName]TagValue{},
 		FieldNames:     []FieldName{},
 		FieldsType:     map[FieldName]FieldType{},
+		FieldsOptions:  map[FieldName]FieldOptions{},
 	}
 	loopControl[typ] = model
 
@@ -101,6 +104,13 @@ func (b *structModelBuilder) populateByStruct(model *Model) error {
 		for _, fieldTagName := range fieldTagNames {
 			populateTags(model, fldName, fieldTagName, tagValues[fieldTagName])
 		}
+		if tagValue, ok := tagValues[Tag]; ok {
+			options, err := ParseFieldOptions(tagValue)
+			if err != nil {
+				return fmt.Errorf("field %s.%s, tag '%s': %w", obj.Name(), fldName, Tag, err)
+			}
+			model.FieldsOptions[fldName] = options
+		}
 
 		refDeep := 0
 		var fieldModel *Model
@@ -151,3 +161,34 @@ func NewFieldType(embedded bool, refDeep int, name string, fieldType types.Type,
 		Model:    fieldModel,
 	}
 }
+
+// FieldComments returns the doc and the line comment of the field declared in the type file of the model.
+func (m *Model) FieldComments(fieldName FieldName) (doc string, comment string) {
+	structType := GetStructType(m.TypeName(), m.TypFile)
+	if structType == nil || structType.Fields == nil {
+		return "", ""
+	}
+	for _, field := range structType.Fields.List {
+		if slices.ContainsFunc(field.Names, func(name *ast.Ident) bool { return name.Name == fieldName }) ||
+			len(field.Names) == 0 && embeddedFieldName(field.Type) == fieldName {
+			return strings.TrimSpace(field.Doc.Text()), strings.TrimSpace(field.Comment.Text())
+		}
+	}
+	return "", ""
+}
+
+func embeddedFieldName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.StarExpr:
+		return embeddedFieldName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return embeddedFieldName(e.X)
+	case *ast.IndexListExpr:
+		return embeddedFieldName(e.X)
+	}
+	return ""
+}
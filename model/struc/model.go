@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"go/ast"
 	"go/types"
+	"strconv"
+	"strings"
 
 	"github.com/m4gshm/fieldr/model/util"
 )
@@ -37,6 +39,7 @@ type (
 		TagsFieldValue map[TagName]map[FieldName]TagValue
 		FieldNames     []FieldName
 		FieldsType     map[FieldName]FieldType
+		FieldsOptions  map[FieldName]FieldOptions
 	}
 )
 
@@ -111,14 +114,22 @@ func parseTagValues(tags string) (map[TagName]TagValue, []TagName) {
 			var endValuePos int
 			for endValuePos = pos; endValuePos < tagValueLen; endValuePos++ {
 				character = rune(tags[endValuePos])
-				if findEndBorder && character == tagValueBorder {
+				if findEndBorder && character == '\\' {
+					// escaped character
+					endValuePos++
+				} else if findEndBorder && character == tagValueBorder {
 					break
 				} else if character == tagDelim {
 					break
 				}
 			}
 
-			tagContent := tags[pos:endValuePos]
+			tagContent := tags[pos:min(endValuePos, tagValueLen)]
+			if findEndBorder && strings.ContainsRune(tagContent, '\\') {
+				if unquoted, err := strconv.Unquote(string(tagValueBorder) + tagContent + string(tagValueBorder)); err == nil {
+					tagContent = unquoted
+				}
+			}
 			var excluded bool
 			if excludedValues, ok := excludeValues[_tagName]; ok {
 				excluded, ok = excludedValues[tagContent]
@@ -0,0 +1,97 @@
+package struc
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// Tag is the struct tag of the field generation options, for example `fieldr:"getter=ID,nosetter,required"`.
+const Tag = "fieldr"
+
+const (
+	OptionSkip     = "-"
+	OptionGetter   = "getter"
+	OptionSetter   = "setter"
+	OptionNoGetter = "nogetter"
+	OptionNoSetter = "nosetter"
+	OptionRequired = "required"
+	OptionExport   = "export"
+	OptionNoExport = "noexport"
+)
+
+// FieldOptions is the per-field generation options parsed from the fieldr tag.
+type FieldOptions struct {
+	// Skip excludes the field from all generated content.
+	Skip bool
+	// Getter, Setter are the accessor method names that override the generated ones.
+	Getter, Setter string
+	// NoGetter, NoSetter disable generating of the accessor methods.
+	NoGetter, NoSetter bool
+	// Required makes the field a mandatory constructor argument or a required builder field.
+	Required bool
+	// Export overrides the export rule of the field methods if not nil.
+	Export *bool
+}
+
+// IsExport returns the export rule of the field methods, the export value is used by default.
+func (o FieldOptions) IsExport(export bool) bool {
+	if o.Export != nil {
+		return *o.Export
+	}
+	return export
+}
+
+// FieldOptions returns the options of the field defined by the fieldr tag.
+func (m *Model) FieldOptions(fieldName FieldName) FieldOptions {
+	if m == nil {
+		return FieldOptions{}
+	}
+	return m.FieldsOptions[fieldName]
+}
+
+// ParseFieldOptions parses the fieldr tag value, a comma separated list of options in the form <option> or <option>=<value>.
+func ParseFieldOptions(tagValue TagValue) (FieldOptions, error) {
+	options := FieldOptions{}
+	if strings.TrimSpace(tagValue) == OptionSkip {
+		options.Skip = true
+		return options, nil
+	}
+	for _, option := range strings.Split(tagValue, ListValuesSeparator) {
+		name, value, hasValue := strings.Cut(strings.TrimSpace(option), ReplaceableValueSeparator)
+		if name, value = strings.TrimSpace(name), strings.TrimSpace(value); len(name) == 0 && !hasValue {
+			continue
+		}
+		switch name {
+		case OptionGetter, OptionSetter:
+			if !token.IsIdentifier(value) {
+				return options, fmt.Errorf("option '%s' expects a method name, actual '%s'", name, value)
+			} else if name == OptionGetter {
+				options.Getter = value
+			} else {
+				options.Setter = value
+			}
+			continue
+		case OptionNoGetter:
+			options.NoGetter = true
+		case OptionNoSetter:
+			options.NoSetter = true
+		case OptionRequired:
+			options.Required = true
+		case OptionExport, OptionNoExport:
+			export := name == OptionExport
+			options.Export = &export
+		default:
+			return options, fmt.Errorf("unsupported option '%s'", option)
+		}
+		if hasValue {
+			return options, fmt.Errorf("option '%s' has no value, actual '%s'", name, value)
+		}
+	}
+	if len(options.Getter) > 0 && options.NoGetter {
+		return options, fmt.Errorf("options '%s' and '%s' are incompatible", OptionGetter, OptionNoGetter)
+	} else if len(options.Setter) > 0 && options.NoSetter {
+		return options, fmt.Errorf("options '%s' and '%s' are incompatible", OptionSetter, OptionNoSetter)
+	}
+	return options, nil
+}
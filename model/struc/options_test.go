@@ -0,0 +1,36 @@
+package struc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseFieldOptions(t *testing.T) {
+	export, noExport := true, false
+	testCases := []struct {
+		input    string
+		expected FieldOptions
+	}{
+		{"-", FieldOptions{Skip: true}},
+		{"", FieldOptions{}},
+		{"getter=ID,nosetter,required", FieldOptions{Getter: "ID", NoSetter: true, Required: true}},
+		{" setter = WithName , nogetter ", FieldOptions{Setter: "WithName", NoGetter: true}},
+		{"export", FieldOptions{Export: &export}},
+		{"noexport", FieldOptions{Export: &noExport}},
+	}
+
+	for _, tc := range testCases {
+		result, err := ParseFieldOptions(tc.input)
+		if err != nil {
+			t.Errorf("ParseFieldOptions(%q) error: %v", tc.input, err)
+		} else if !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("ParseFieldOptions(%q) = %+v; want %+v", tc.input, result, tc.expected)
+		}
+	}
+
+	for _, input := range []string{"unknown", "getter", "getter=1D", "required=true", "setter=Set,nosetter", "-,required"} {
+		if _, err := ParseFieldOptions(input); err == nil {
+			t.Errorf("ParseFieldOptions(%q) expected error", input)
+		}
+	}
+}
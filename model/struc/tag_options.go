@@ -0,0 +1,107 @@
+package struc
+
+import "strings"
+
+// TagOptions is the parsed struct tag value like `json:"name,omitempty"`, `validate:"required,oneof='a b' c"`
+// or `gorm:"column:id;primaryKey"`.
+type TagOptions struct {
+	// Name is the first element of the value if it is not a key/value option, like 'name' of `json:"name,omitempty"`.
+	Name string
+	// Options are the elements that follow the name.
+	Options []string
+	// Values are the key/value options in the form key=value or key:value.
+	Values map[string]string
+}
+
+// Has returns true if the name, an option or an option key equals to the option.
+func (o TagOptions) Has(option string) bool {
+	if len(option) == 0 {
+		return false
+	} else if o.Name == option {
+		return true
+	} else if _, ok := o.Values[option]; ok {
+		return true
+	}
+	for _, opt := range o.Options {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
+
+// Value returns the value of the key/value option.
+func (o TagOptions) Value(key string) (string, bool) {
+	value, ok := o.Values[key]
+	return value, ok
+}
+
+// TagOptions returns the parsed value of the field tag.
+func (m *Model) TagOptions(fieldName FieldName, tagName TagName) (TagOptions, bool) {
+	if m == nil {
+		return TagOptions{}, false
+	}
+	tagValue, ok := m.FieldsTagValue[fieldName][tagName]
+	if !ok {
+		return TagOptions{}, false
+	}
+	return ParseTagOptions(tagValue), true
+}
+
+// ParseTagOptions parses the tag value as the ';' separated options if the value contains ';' otherwise as the ',' separated.
+// Single quoted parts of an option are not split, the quotes of a single quoted key/value option value are removed.
+func ParseTagOptions(tagValue TagValue) TagOptions {
+	separator := ','
+	if len(splitQuoted(tagValue, ';')) > 1 {
+		separator = ';'
+	}
+	options := TagOptions{Values: map[string]string{}}
+	for i, element := range splitQuoted(tagValue, separator) {
+		element = strings.TrimSpace(element)
+		key, value, isKeyValue := cutKeyValue(element)
+		if i == 0 && !isKeyValue {
+			options.Name = element
+			continue
+		} else if len(element) == 0 {
+			continue
+		} else if isKeyValue {
+			options.Values[key] = unquote(value)
+		}
+		options.Options = append(options.Options, element)
+	}
+	return options
+}
+
+func cutKeyValue(element string) (string, string, bool) {
+	for i, c := range element {
+		switch c {
+		case '\'':
+			return "", "", false
+		case '=', ':':
+			return strings.TrimSpace(element[:i]), strings.TrimSpace(element[i+1:]), i > 0
+		}
+	}
+	return "", "", false
+}
+
+func splitQuoted(value string, separator rune) []string {
+	elements := []string{}
+	quoted := false
+	start := 0
+	for i, c := range value {
+		if c == '\'' {
+			quoted = !quoted
+		} else if c == separator && !quoted {
+			elements = append(elements, value[start:i])
+			start = i + 1
+		}
+	}
+	return append(elements, value[start:])
+}
+
+func unquote(value string) string {
+	if len(value) > 1 && value[0] == '\'' && value[len(value)-1] == '\'' && strings.Count(value, "'") == 2 {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -0,0 +1,41 @@
+package struc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTagOptions(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected TagOptions
+	}{
+		{"name,omitempty", TagOptions{Name: "name", Options: []string{"omitempty"}, Values: map[string]string{}}},
+		{",omitempty", TagOptions{Options: []string{"omitempty"}, Values: map[string]string{}}},
+		{"-", TagOptions{Name: "-", Values: map[string]string{}}},
+		{"column:id;primaryKey", TagOptions{Options: []string{"column:id", "primaryKey"}, Values: map[string]string{"column": "id"}}},
+		{"required,oneof='a b' 'c,d',min=1", TagOptions{Name: "required", Options: []string{"oneof='a b' 'c,d'", "min=1"},
+			Values: map[string]string{"oneof": "'a b' 'c,d'", "min": "1"}}},
+		{"default:'a;b';not null", TagOptions{Options: []string{"default:'a;b'", "not null"}, Values: map[string]string{"default": "a;b"}}},
+	}
+	for _, tc := range testCases {
+		if result := ParseTagOptions(tc.input); !reflect.DeepEqual(result, tc.expected) {
+			t.Errorf("ParseTagOptions(%q) = %+v; want %+v", tc.input, result, tc.expected)
+		}
+	}
+
+	options := ParseTagOptions("required,min=1")
+	if !options.Has("required") || !options.Has("min") || options.Has("max") {
+		t.Errorf("unexpected Has result of %+v", options)
+	}
+	if value, ok := options.Value("min"); !ok || value != "1" {
+		t.Errorf("unexpected Value result %q of %+v", value, options)
+	}
+}
+
+func TestParseTagValuesEscaped(t *testing.T) {
+	values, names := parseTagValues(`json:"a\"b" db:"c"`)
+	if !reflect.DeepEqual(names, []TagName{"json", "db"}) || values["json"] != `a"b` || values["db"] != "c" {
+		t.Errorf("unexpected parse result %v %v", names, values)
+	}
+}
@@ -41,6 +41,26 @@ func ExtractPackages(fileSet *token.FileSet, buildTags []string, fileName string
 	}
 }
 
+// LoadPackageTypes loads the types of the package by the import path resolved in the directory.
+func LoadPackageTypes(fileSet *token.FileSet, buildTags []string, dir, pkgPath string) (*types.Package, error) {
+	pkgs, err := packages.Load(&packages.Config{
+		Dir:        dir,
+		Fset:       fileSet,
+		Mode:       packages.NeedName | packages.NeedTypes,
+		BuildFlags: buildTagsArg(buildTags),
+		Logf:       func(format string, args ...any) { logger.Debugf("packagesLoad: "+format, args...) },
+	}, pkgPath)
+	if err != nil {
+		return nil, err
+	} else if len(pkgs) != 1 {
+		return nil, fmt.Errorf("unexpected packages count %d of '%s'", len(pkgs), pkgPath)
+	} else if pkg := pkgs[0]; len(pkg.Errors) > 0 {
+		return nil, fmt.Errorf("load package '%s': %w", pkgPath, pkg.Errors[0])
+	} else {
+		return pkg.Types, nil
+	}
+}
+
 func buildTagsArg(buildTags []string) []string {
 	return []string{fmt.Sprintf("-tags=%s", strings.Join(buildTags, " "))}
 }
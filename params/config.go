@@ -45,6 +45,10 @@ func Export(flagSet *flag.FlagSet) *bool {
 	return ExportCont(flagSet, "content")
 }
 
+func Default(flagSet *flag.FlagSet) *[]string {
+	return MultiVal(flagSet, "default", []string{}, "a field default value in the form <Field>=<Go expression> (the tag 'default:\"<Go expression>\"' sets a default value too)")
+}
+
 func Flat(flagSet *flag.FlagSet) *[]string {
 	return MultiVal(flagSet, "flat", []string{}, "apply generator to fields of nested structs")
 }